@@ -0,0 +1,100 @@
+package mocka
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// waiter describes a behavior that blocks a call to the stub before it returns.
+// The restored channel is closed when the stub is restored. A non-nil error
+// replaces the trailing error out parameter of the call.
+type waiter func(arguments []interface{}, restored <-chan struct{}) error
+
+// delay returns a waiter that sleeps for the provided duration
+func delay(d time.Duration) waiter {
+	return func(_ []interface{}, restored <-chan struct{}) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-restored:
+		}
+
+		return nil
+	}
+}
+
+// blockUntil returns a waiter that blocks until the provided channel
+// is closed or receives a value
+func blockUntil(ch <-chan struct{}) waiter {
+	return func(_ []interface{}, restored <-chan struct{}) error {
+		select {
+		case <-ch:
+		case <-restored:
+		}
+
+		return nil
+	}
+}
+
+// blockUntilContextDone returns a waiter that blocks until the first
+// context.Context argument is done and returns the context's error
+func blockUntilContextDone() waiter {
+	return func(arguments []interface{}, restored <-chan struct{}) error {
+		ctx := findContext(arguments)
+		if ctx == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-restored:
+			return nil
+		}
+	}
+}
+
+// findContext returns the first non-nil context.Context in the arguments; otherwise nil
+func findContext(arguments []interface{}) context.Context {
+	for _, arg := range arguments {
+		if ctx, ok := arg.(context.Context); ok && ctx != nil {
+			return ctx
+		}
+	}
+
+	return nil
+}
+
+// hasContextArgument returns true if any of the function's arguments can hold a context.Context
+func hasContextArgument(functionType reflect.Type) bool {
+	for i := 0; i < functionType.NumIn(); i++ {
+		if argType := functionType.In(i); argType.Kind() == reflect.Interface && contextType.Implements(argType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// errorOutIndex returns the index of the trailing error out parameter; otherwise -1
+func errorOutIndex(functionType reflect.Type) int {
+	numOut := functionType.NumOut()
+	if numOut == 0 || functionType.Out(numOut-1) != errorType {
+		return -1
+	}
+
+	return numOut - 1
+}
+
+// reportMissingContextArgument reports that the function does not accept a context.Context
+func reportMissingContextArgument(testReporter TestReporter, functionType reflect.Type) {
+	testReporter.Errorf("mocka: expected a function with a context.Context argument for BlockUntilContextDone, but received %v", toFriendlyName(functionType))
+}
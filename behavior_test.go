@@ -0,0 +1,107 @@
+package mocka
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("behavior", func() {
+	var restored chan struct{}
+
+	BeforeEach(func() {
+		restored = make(chan struct{})
+	})
+
+	Describe("delay", func() {
+		It("sleeps for the provided duration", func() {
+			start := time.Now()
+
+			err := delay(20*time.Millisecond)(nil, restored)
+
+			Expect(err).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+		})
+
+		It("returns early when the stub is restored", func() {
+			close(restored)
+
+			Expect(delay(time.Hour)(nil, restored)).To(BeNil())
+		})
+	})
+
+	Describe("blockUntil", func() {
+		It("blocks until the channel is closed", func() {
+			ch := make(chan struct{})
+			done := make(chan struct{})
+
+			go func() {
+				defer close(done)
+				_ = blockUntil(ch)(nil, restored)
+			}()
+
+			Consistently(done, 20*time.Millisecond).ShouldNot(BeClosed())
+			close(ch)
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("returns early when the stub is restored", func() {
+			close(restored)
+
+			Expect(blockUntil(make(chan struct{}))(nil, restored)).To(BeNil())
+		})
+	})
+
+	Describe("blockUntilContextDone", func() {
+		It("returns the context error once the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := blockUntilContextDone()([]interface{}{"key", ctx}, restored)
+
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("does not block if there is no context argument", func() {
+			Expect(blockUntilContextDone()([]interface{}{"key", nil}, restored)).To(BeNil())
+		})
+
+		It("returns nil when the stub is restored", func() {
+			close(restored)
+
+			Expect(blockUntilContextDone()([]interface{}{context.Background()}, restored)).To(BeNil())
+		})
+	})
+
+	DescribeTable("hasContextArgument",
+		func(fn interface{}, expected bool) {
+			Expect(hasContextArgument(reflect.TypeOf(fn))).To(Equal(expected))
+		},
+		Entry("with a context.Context argument", func(context.Context, string) {}, true),
+		Entry("with an interface{} argument", func(interface{}) {}, true),
+		Entry("without a context argument", func(string, error) {}, false),
+	)
+
+	DescribeTable("errorOutIndex",
+		func(fn interface{}, expected int) {
+			Expect(errorOutIndex(reflect.TypeOf(fn))).To(Equal(expected))
+		},
+		Entry("with a trailing error", func() (int, error) { return 0, nil }, 1),
+		Entry("with only an error", func() error { return nil }, 0),
+		Entry("without out parameters", func() {}, -1),
+		Entry("with an error that is not trailing", func() (error, int) { return nil, 0 }, -1),
+	)
+
+	Describe("findContext", func() {
+		It("returns the first context", func() {
+			first := context.WithValue(context.Background(), "key", "first")
+
+			Expect(findContext([]interface{}{errors.New("ope"), first, context.Background()})).To(Equal(first))
+		})
+	})
+})
@@ -2,8 +2,11 @@ package mocka
 
 // Call represents the information for a specific call invocation of the stubbed function
 type Call struct {
-	args []interface{}
-	out  []interface{}
+	args       []interface{}
+	out        []interface{}
+	fuzzInput  []byte
+	resolution Resolution
+	rules      []ruleOutcome
 }
 
 // Arguments returns the arguments that stub was called with.
@@ -15,3 +18,24 @@ func (c Call) Arguments() []interface{} {
 func (c Call) ReturnValues() []interface{} {
 	return c.out
 }
+
+// FuzzInput returns the fuzz input bytes that were decoded into the return values
+// of the call. It returns nil if the stub was not using FuzzReturns.
+func (c Call) FuzzInput() []byte {
+	return c.fuzzInput
+}
+
+// Resolution returns how the return values of the call were resolved, including
+// the WithArgs rules that matched the arguments and their priorities.
+func (c Call) Resolution() Resolution {
+	return c.resolution
+}
+
+// rule returns the WithArgs rule used by the call; otherwise nil
+func (c Call) rule() *CustomArguments {
+	if c.resolution.Rule < 0 || c.resolution.Rule >= len(c.rules) {
+		return nil
+	}
+
+	return c.rules[c.resolution.Rule].customArgs
+}
@@ -31,4 +31,18 @@ var _ = Describe("call", func() {
 			Expect(result).To(Equal([]interface{}{40, nil}))
 		})
 	})
+
+	Describe("Resolution", func() {
+		It("returns the resolution of the return values of the call", func() {
+			testCall := &Call{
+				args:       []interface{}{42, "hello"},
+				out:        []interface{}{40, nil},
+				resolution: Resolution{Source: WithArgsReturn, Rule: 1},
+			}
+
+			result := testCall.Resolution()
+
+			Expect(result).To(Equal(Resolution{Source: WithArgsReturn, Rule: 1}))
+		})
+	})
 })
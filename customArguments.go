@@ -1,8 +1,11 @@
 package mocka
 
 import (
+	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/Bayer-Group/mocka/v2/internal/captures"
 	"github.com/Bayer-Group/mocka/v2/match"
 )
 
@@ -20,6 +23,11 @@ func newCustomArguments(stub *Stub, arguments []interface{}) *CustomArguments {
 		return nil
 	}
 
+	// captured values are held until the custom arguments used by a call are known
+	for _, m := range matchers {
+		captures.Hold(m)
+	}
+
 	return &CustomArguments{stub: stub, callCount: 0, argMatchers: matchers}
 }
 
@@ -99,6 +107,8 @@ type CustomArguments struct {
 	argMatchers []match.SupportedKindsMatcher
 	out         []interface{}
 	onCalls     []*OnCall
+	sequence    *Sequence
+	wait        waiter
 	callCount   int
 }
 
@@ -115,6 +125,23 @@ func (ca *CustomArguments) Return(returnValues ...interface{}) {
 	ca.out = returnValues
 }
 
+// ReturnsInOrder sets the return values for each call with this set of
+// custom arguments in the order they are provided. Once every set of return
+// values has been used it will fall back to the return values of the custom
+// arguments, unless a different ExhaustionPolicy is set with WhenExhausted.
+func (ca *CustomArguments) ReturnsInOrder(returnValues ...[]interface{}) *Sequence {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	sequence := newSequence(ca.stub, &ca.onCalls, returnValues)
+	if sequence.values != nil {
+		ca.sequence.release()
+		ca.sequence = sequence
+	}
+
+	return sequence
+}
+
 // OnCall returns an interface that allows for changing the
 // return values based on the call index for this specific set
 // of custom arguments.
@@ -122,15 +149,33 @@ func (ca *CustomArguments) OnCall(callIndex int) *OnCall {
 	ca.stub.lock.Lock()
 	defer ca.stub.lock.Unlock()
 
-	for _, o := range ca.onCalls {
-		if o.index == callIndex {
-			return o
-		}
+	return getOrAddOnCall(ca.stub, &ca.onCalls, callIndex, callIndex)
+}
+
+// OnCalls returns an interface that allows for changing the
+// return values for a range of call indexes for this specific
+// set of custom arguments. Both the from and to call indexes
+// are inclusive.
+func (ca *CustomArguments) OnCalls(from int, to int) *OnCall {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	if to < from {
+		ca.stub.testReporter.Errorf("mocka: expected the last call index (%v) to be greater than or equal to the first call index (%v)", to, from)
+		return &OnCall{index: from, stub: ca.stub}
 	}
 
-	o := &OnCall{index: callIndex, stub: ca.stub}
-	ca.onCalls = append(ca.onCalls, o)
-	return o
+	return getOrAddOnCall(ca.stub, &ca.onCalls, from, to)
+}
+
+// OnCallsFrom returns an interface that allows for changing the
+// return values for every call from the provided call index onwards
+// for this specific set of custom arguments.
+func (ca *CustomArguments) OnCallsFrom(callIndex int) *OnCall {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	return getOrAddOnCall(ca.stub, &ca.onCalls, callIndex, lastCallIndex)
 }
 
 // OnFirstCall returns an interface that allows for changing the
@@ -154,20 +199,81 @@ func (ca *CustomArguments) OnThirdCall() *OnCall {
 	return ca.OnCall(2)
 }
 
-// isMatch returns false if any of the argument matchers return false or
-// if there is a panic from inside a matcher; otherwise true
-func (ca *CustomArguments) isMatch(arguments []interface{}) (isMatch bool) {
+// Delay makes the stub sleep for the provided duration before returning
+// for this specific set of custom arguments
+func (ca *CustomArguments) Delay(d time.Duration) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	ca.wait = delay(d)
+}
+
+// BlockUntil makes the stub block until the provided channel is closed
+// or receives a value before returning for this specific set of custom arguments
+func (ca *CustomArguments) BlockUntil(ch <-chan struct{}) {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	ca.wait = blockUntil(ch)
+}
+
+// BlockUntilContextDone makes the stub block until the first context.Context
+// argument is done for this specific set of custom arguments. The context's
+// error will be returned as the error out parameter, if the function returns
+// an error.
+func (ca *CustomArguments) BlockUntilContextDone() {
+	ca.stub.lock.Lock()
+	defer ca.stub.lock.Unlock()
+
+	if !hasContextArgument(ca.stub.toType()) {
+		reportMissingContextArgument(ca.stub.testReporter, ca.stub.toType())
+		return
+	}
+
+	ca.wait = blockUntilContextDone()
+}
+
+// evaluate matches the arguments against their matchers until one does not
+// match, so like for any call the matchers after it are not run. It returns
+// the index of the argument that did not match, or -1 if every argument
+// matched, along with the reason it did not match if its matcher panicked
+// or is missing.
+func (ca *CustomArguments) evaluate(arguments []interface{}) (failed int, reason string) {
+	for i, arg := range arguments {
+		if i >= len(ca.argMatchers) {
+			return i, "the rule has no matcher for the argument"
+		}
+
+		if matched, reason := ca.matchArgument(ca.argMatchers[i], arg); !matched {
+			return i, reason
+		}
+	}
+
+	return -1, ""
+}
+
+// matchArgument returns true if the argument matches the matcher; otherwise
+// false. Panics from inside a matcher do not match and are returned as the
+// reason, and panics from inside the predicate of a match.Satisfies matcher
+// are reported.
+func (ca *CustomArguments) matchArgument(matcher match.SupportedKindsMatcher, arg interface{}) (matched bool, reason string) {
 	defer func() {
 		if r := recover(); r != nil {
-			isMatch = false
+			matched, reason = false, fmt.Sprintf("%v panicked: %v", match.Description(matcher), r)
+			if p, ok := r.(*match.PredicatePanic); ok {
+				ca.stub.testReporter.Errorf("mocka: %v", p)
+				reason = p.Error()
+			}
 		}
 	}()
 
-	for i, arg := range arguments {
-		if !ca.argMatchers[i].Match(arg) {
-			return false
-		}
-	}
+	return matcher.Match(arg), ""
+}
 
-	return true
+// settleCaptures stores the values captured by the argument matchers if the
+// custom arguments are used for the call; otherwise they are discarded
+func (ca *CustomArguments) settleCaptures(used bool) {
+	for _, m := range ca.argMatchers {
+		captures.Settle(m, used)
+	}
 }
@@ -3,6 +3,7 @@ package mocka
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	"github.com/Bayer-Group/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
@@ -52,7 +53,19 @@ var _ = Describe("CustomArguments", func() {
 			})
 
 			Expect(failTestReporter.messages).To(Equal([]string{
-				"mocka: expected arguments of type (string, int), but received (*anything, *lengthOf)",
+				"mocka: expected arguments of type (string, int), but received (anything, value with length 10)\n" +
+					"\targ 2: value with length 10 does not support arguments of kind int",
+			}))
+		})
+
+		It("reports an error if the provided regular expression cannot be parsed", func() {
+			stub.testReporter = failTestReporter
+
+			_ = newCustomArguments(stub, []interface{}{match.StringMatching("("), 1})
+
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, int), but received (string matching invalid regular expression \"(\", int)\n" +
+					"\targ 1: string matching invalid regular expression \"(\" does not support arguments of kind string",
 			}))
 		})
 
@@ -156,7 +169,8 @@ var _ = Describe("CustomArguments", func() {
 				_ = newCustomArguments(stub, []interface{}{"hi", match.ElementsContaining("A")})
 
 				Expect(failTestReporter.messages).To(Equal([]string{
-					"mocka: expected arguments of type (string, ...), but received (string, *elementsContaining)",
+					"mocka: expected arguments of type (string, ...), but received (string, elements containing (\"A\"))\n" +
+						"\targ 2: elements containing (\"A\") does not support arguments of kind interface",
 				}))
 			})
 		})
@@ -227,6 +241,127 @@ var _ = Describe("CustomArguments", func() {
 		})
 	})
 
+	Describe("OnCalls", func() {
+		It("returns a pointer to an onCall struct covering the range", func() {
+			ca := &CustomArguments{stub: stub}
+
+			result := ca.OnCalls(1, 3)
+
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 1, lastIndex: 3}))
+			Expect(ca.onCalls).To(HaveLen(1))
+		})
+
+		It("reports an error when to is lower than from", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			_ = ca.OnCalls(3, 1)
+
+			Expect(ca.onCalls).To(BeEmpty())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the last call index (1) to be greater than or equal to the first call index (3)",
+			}))
+		})
+	})
+
+	Describe("OnCallsFrom", func() {
+		It("returns a pointer to an onCall struct covering every call after the index", func() {
+			ca := &CustomArguments{stub: stub}
+
+			result := ca.OnCallsFrom(2)
+
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 2, lastIndex: lastCallIndex}))
+		})
+	})
+
+	Describe("ReturnsInOrder", func() {
+		It("assigns the return values to the onCalls for each index", func() {
+			ca := &CustomArguments{stub: stub}
+
+			sequence := ca.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil})
+
+			Expect(ca.sequence).To(BeIdenticalTo(sequence))
+			Expect(ca.onCalls).To(Equal([]*OnCall{
+				{stub: stub, index: 0, out: []interface{}{1, nil}, sequence: sequence},
+				{stub: stub, index: 1, out: []interface{}{2, nil}, sequence: sequence},
+			}))
+		})
+
+		It("repeats the last values for the matching arguments when the policy is RepeatLast", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.WithArgs("A", 1).ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}).WhenExhausted(RepeatLast)
+
+			results := make([]int, 4)
+			for i := range results {
+				_, _ = fn("B", 1)
+				results[i], _ = fn("A", 1)
+			}
+
+			Expect(results).To(Equal([]int{1, 2, 2, 2}))
+		})
+
+		It("replaces the values of a previous sequence for the matching arguments", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.WithArgs("A", 1).ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}, []interface{}{3, nil})
+			fnStub.WithArgs("A", 1).ReturnsInOrder([]interface{}{9, nil})
+
+			results := make([]int, 3)
+			for i := range results {
+				results[i], _ = fn("A", 1)
+			}
+
+			Expect(results).To(Equal([]int{9, 0, 0}))
+		})
+	})
+
+	Describe("Delay", func() {
+		It("assigns the waiter", func() {
+			ca := &CustomArguments{stub: stub}
+
+			ca.Delay(time.Millisecond)
+
+			Expect(ca.wait).ToNot(BeNil())
+		})
+	})
+
+	Describe("BlockUntil", func() {
+		It("blocks the matching arguments until the channel is closed", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			ch := make(chan struct{})
+			done := make(chan struct{})
+			fnStub.WithArgs("block", 0).BlockUntil(ch)
+
+			go func() {
+				defer close(done)
+				_, _ = fn("block", 0)
+			}()
+
+			Eventually(fnStub.CallCount).Should(Equal(1))
+			_, _ = fn("free", 0)
+			Consistently(done, 20*time.Millisecond).ShouldNot(BeClosed())
+			close(ch)
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	Describe("BlockUntilContextDone", func() {
+		It("reports an error if the function does not have a context argument", func() {
+			stub.testReporter = failTestReporter
+			ca := &CustomArguments{stub: stub}
+
+			ca.BlockUntilContextDone()
+
+			Expect(ca.wait).To(BeNil())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+	})
+
 	Describe("OnFirstCall", func() {
 		It("creates a new onCall with a 0 index", func() {
 			ca := &CustomArguments{
@@ -326,23 +461,58 @@ var _ = Describe("CustomArguments", func() {
 		})
 	})
 
-	Describe("isMatch", func() {
-		It("returns false if any matcher panics", func() {
+	Describe("evaluate", func() {
+		It("does not match an argument if its matcher panics", func() {
 			ca := newCustomArguments(stub, []interface{}{&panicMatcher{}, match.IntGreaterThan(10)})
 
-			Expect(ca.isMatch([]interface{}{"hi", 11})).To(BeFalse())
+			failed, reason := ca.evaluate([]interface{}{"hi", 11})
+
+			Expect(failed).To(Equal(0))
+			Expect(reason).To(ContainSubstring("panicked"))
 		})
 
-		It("returns false if any matcher returns false", func() {
+		It("reports the panic once if a predicate panics", func() {
+			stub.testReporter = failTestReporter
+			ca := newCustomArguments(stub, []interface{}{match.Satisfies(func(s string) bool { panic("boom") }), match.IntGreaterThan(10)})
+
+			failed, reason := ca.evaluate([]interface{}{"hi", 11})
+
+			Expect(failed).To(Equal(0))
+			Expect(reason).To(Equal(`the predicate func(string) bool panicked when matching "hi": boom`))
+			Expect(failTestReporter.messages).To(Equal([]string{
+				`mocka: the predicate func(string) bool panicked when matching "hi": boom`,
+			}))
+		})
+
+		It("returns the first argument that does not match without a reason", func() {
 			ca := newCustomArguments(stub, []interface{}{"hi", match.IntGreaterThan(10)})
 
-			Expect(ca.isMatch([]interface{}{"hi", 5})).To(BeFalse())
+			failed, reason := ca.evaluate([]interface{}{"hi", 5})
+
+			Expect(failed).To(Equal(1))
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("does not run the matchers after the first argument that does not match", func() {
+			called := false
+			ca := newCustomArguments(stub, []interface{}{"bye", match.Satisfies(func(int) bool {
+				called = true
+				return true
+			})})
+
+			failed, _ := ca.evaluate([]interface{}{"hi", 5})
+
+			Expect(failed).To(Equal(0))
+			Expect(called).To(BeFalse())
 		})
 
-		It("returns true if all matchers return true", func() {
+		It("matches every argument if all matchers return true", func() {
 			ca := newCustomArguments(stub, []interface{}{"hi", match.IntGreaterThan(10)})
 
-			Expect(ca.isMatch([]interface{}{"hi", 15})).To(BeTrue())
+			failed, reason := ca.evaluate([]interface{}{"hi", 15})
+
+			Expect(failed).To(Equal(-1))
+			Expect(reason).To(BeEmpty())
 		})
 	})
 })
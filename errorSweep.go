@@ -0,0 +1,216 @@
+package mocka
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// ErrorSweep runs the test body once to discover every call made to the stubs
+// in the sandbox that return an error. The test body is then run again for
+// each of those calls, injecting an error into that call only using OnCall.
+//
+// The injected error is passed into the test body, which is nil for the
+// discovery run. Any run where the test body panics or reports a failure
+// through the provided TestReporter will be reported with the call that
+// had the error injected.
+//
+// Stubs must be created in the sandbox before calling ErrorSweep. Before every
+// run the call history of each stub is cleared and its fuzz input and fault
+// stream are rewound, so no run depends on the state of an earlier run. The
+// failures reported by the stubs are reported with the run they happened in.
+func ErrorSweep(testReporter TestReporter, sandbox *Sandbox, body func(t TestReporter, injected error)) {
+	testReporter = ensureTestReporter(testReporter, log.Fatal)
+	if sandbox == nil {
+		testReporter.Errorf("mocka: expected a sandbox for ErrorSweep, but received a nil")
+		return
+	}
+
+	all := sandbox.sandboxStubs()
+	stubs := errorStubs(all)
+	for _, stub := range all {
+		stub.resetRun()
+	}
+
+	if !runSweep(testReporter, "discovery run", all, body, nil) {
+		return
+	}
+
+	discovered := make(map[*Stub][]Call, len(stubs))
+	var total int
+	for _, stub := range stubs {
+		discovered[stub] = stub.GetCalls()
+		total += len(discovered[stub])
+	}
+
+	if total == 0 {
+		testReporter.Errorf("mocka: ErrorSweep did not find any calls to stubs that return an error")
+		return
+	}
+
+	for _, stub := range stubs {
+		for callIndex := range discovered[stub] {
+			for _, s := range all {
+				s.resetRun()
+			}
+
+			name := fmt.Sprintf("run injecting an error into call %v of %v", callIndex, toFriendlyName(stub.toType()))
+			injected := fmt.Errorf("mocka: error injected by ErrorSweep into call %v of %v", callIndex, toFriendlyName(stub.toType()))
+			restore := stub.injectError(discovered[stub], callIndex, injected)
+			runSweep(testReporter, name, all, body, injected)
+			restore()
+		}
+	}
+}
+
+// runSweep runs a single iteration of the error sweep and reports any panics or
+// failures, including those reported by the stubs; it returns false if the run failed
+func runSweep(testReporter TestReporter, name string, stubs []*Stub, body func(TestReporter, error), injected error) (passed bool) {
+	runReporter := &sweepReporter{}
+	for _, stub := range stubs {
+		previous := stub.swapTestReporter(runReporter)
+		defer stub.swapTestReporter(previous)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			testReporter.Errorf("mocka: ErrorSweep %v panicked: %v", name, r)
+			passed = false
+		}
+	}()
+
+	body(runReporter, injected)
+
+	messages := runReporter.collected()
+	for _, message := range messages {
+		testReporter.Errorf("mocka: ErrorSweep %v: %v", name, message)
+	}
+
+	return len(messages) == 0
+}
+
+// sweepReporter collects the failures reported by a single error sweep run
+type sweepReporter struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+// Errorf appends the failure message to the internal messages slice
+func (r *sweepReporter) Errorf(format string, args ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+// collected returns the failure messages reported so far
+func (r *sweepReporter) collected() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]string(nil), r.messages...)
+}
+
+// sandboxStubs returns the stubs that were created in the sandbox
+func (s *Sandbox) sandboxStubs() []*Stub {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var stubs []*Stub
+	for _, stub := range s.stubs {
+		if stub != nil {
+			stubs = append(stubs, stub)
+		}
+	}
+
+	return stubs
+}
+
+// errorStubs returns the stubs whose last return value is an error
+func errorStubs(stubs []*Stub) []*Stub {
+	var errStubs []*Stub
+	for _, stub := range stubs {
+		if errorOutIndex(stub.toType()) != -1 {
+			errStubs = append(errStubs, stub)
+		}
+	}
+
+	return errStubs
+}
+
+// resetRun clears the call history of the stub and its custom arguments and
+// rewinds its fuzz input and fault stream, so the sequences and faults of the
+// stub start over
+func (stub *Stub) resetRun() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.calls = nil
+	for _, ca := range stub.customArgs {
+		if ca != nil {
+			ca.callCount = 0
+		}
+	}
+
+	if stub.fuzz != nil {
+		stub.fuzz = &fuzzDecoder{data: stub.fuzz.data}
+	}
+
+	if stub.faults != nil {
+		stub.faults = newFaultInjector(stub.faults.policy)
+	}
+}
+
+// swapTestReporter replaces the test reporter of the stub, returning the previous one
+func (stub *Stub) swapTestReporter(testReporter TestReporter) TestReporter {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	previous := stub.testReporter
+	stub.testReporter = testReporter
+	return previous
+}
+
+// injectError makes the call at the provided index return the error using the
+// most specific OnCall for that call, based on the WithArgs rules used by the
+// calls that were discovered.
+// The returned function restores the OnCall to its previous return values.
+func (stub *Stub) injectError(discovered []Call, callIndex int, err error) func() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	call := discovered[callIndex]
+	out := make([]interface{}, len(call.out))
+	copy(out, call.out)
+	out[errorOutIndex(stub.toType())] = err
+
+	onCalls := &stub.onCalls
+	onCallIndex := callIndex
+
+	if ca := call.rule(); ca != nil {
+		onCalls = &ca.onCalls
+		onCallIndex = 0
+		for _, previous := range discovered[:callIndex] {
+			if previous.rule() == ca {
+				onCallIndex++
+			}
+		}
+	}
+
+	existing := len(*onCalls)
+	o := getOrAddOnCall(stub, onCalls, onCallIndex, onCallIndex)
+	previousOut := o.out
+	o.out = out
+
+	return func() {
+		stub.lock.Lock()
+		defer stub.lock.Unlock()
+
+		if len(*onCalls) > existing {
+			*onCalls = (*onCalls)[:existing]
+			return
+		}
+
+		o.out = previousOut
+	}
+}
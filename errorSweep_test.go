@@ -0,0 +1,222 @@
+package mocka
+
+import (
+	"errors"
+
+	"github.com/Bayer-Group/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorSweep", func() {
+	var (
+		fetch            func(string) (string, error)
+		save             func(string) error
+		count            func() int
+		sandbox          *Sandbox
+		failTestReporter *mockTestReporter
+	)
+
+	process := func(keys ...string) error {
+		for _, key := range keys {
+			value, err := fetch(key)
+			if err != nil {
+				return err
+			}
+
+			if err := save(value); err != nil {
+				return err
+			}
+		}
+
+		_ = count()
+		return nil
+	}
+
+	BeforeEach(func() {
+		fetch = func(key string) (string, error) { return key, nil }
+		save = func(string) error { return nil }
+		count = func() int { return 0 }
+		failTestReporter = &mockTestReporter{}
+		sandbox = CreateSandbox(GinkgoT())
+		_ = sandbox.Function(&fetch, "value", nil)
+		_ = sandbox.Function(&save, nil)
+		_ = sandbox.Function(&count, 1)
+	})
+
+	AfterEach(func() {
+		sandbox.Restore()
+	})
+
+	It("reports an error if the sandbox is nil", func() {
+		ErrorSweep(failTestReporter, nil, func(TestReporter, error) {})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: expected a sandbox for ErrorSweep, but received a nil",
+		}))
+	})
+
+	It("injects an error into each call that returns an error", func() {
+		var injectedErrors []error
+		var returnedErrors []error
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			injectedErrors = append(injectedErrors, injected)
+			returnedErrors = append(returnedErrors, process("A", "B"))
+		})
+
+		Expect(failTestReporter.messages).To(BeEmpty())
+		Expect(injectedErrors).To(HaveLen(5))
+		Expect(injectedErrors[0]).To(BeNil())
+		Expect(returnedErrors).To(Equal(injectedErrors))
+		Expect(injectedErrors[1]).To(MatchError("mocka: error injected by ErrorSweep into call 0 of func(string) (string, error) {}"))
+		Expect(injectedErrors[4]).To(MatchError("mocka: error injected by ErrorSweep into call 1 of func(string) (error) {}"))
+	})
+
+	It("reports the runs where the test body reports a failure", func() {
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			err := process("A")
+			if injected != nil && !errors.Is(err, injected) && err != nil {
+				t.Errorf("expected the injected error but got %v", err)
+			}
+
+			if injected != nil && err == nil {
+				t.Errorf("the error was swallowed")
+			}
+		})
+
+		Expect(failTestReporter.messages).To(BeEmpty())
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			_, _ = fetch("A")
+			if err := save("A"); injected != nil && err == nil {
+				t.Errorf("the error was swallowed")
+			}
+		})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: ErrorSweep run injecting an error into call 0 of func(string) (string, error) {}: the error was swallowed",
+		}))
+	})
+
+	It("reports the runs where the test body panics", func() {
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			if _, err := fetch("A"); err != nil {
+				panic("Ope")
+			}
+		})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: ErrorSweep run injecting an error into call 0 of func(string) (string, error) {} panicked: Ope",
+		}))
+	})
+
+	It("stops if the discovery run fails", func() {
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			t.Errorf("Ope")
+		})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: ErrorSweep discovery run: Ope",
+		}))
+	})
+
+	It("reports an error if no calls returning an error were found", func() {
+		ErrorSweep(failTestReporter, sandbox, func(TestReporter, error) {})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: ErrorSweep did not find any calls to stubs that return an error",
+		}))
+	})
+
+	It("injects the error into calls that match custom arguments", func() {
+		sandbox.stubs[0].WithArgs("B").Return("custom", nil)
+		var fetched []string
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			for _, key := range []string{"A", "B", "B"} {
+				if _, err := fetch(key); err != nil {
+					fetched = append(fetched, key)
+				}
+			}
+		})
+
+		Expect(failTestReporter.messages).To(BeEmpty())
+		Expect(fetched).To(Equal([]string{"A", "B", "B"}))
+	})
+
+	It("does not evaluate the matchers of the discovered calls again", func() {
+		var keys []string
+		sandbox.stubs[0].WithArgs(match.CaptureAll(&keys)).Return("custom", nil)
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			_, _ = fetch("A")
+			_, _ = fetch("B")
+		})
+
+		Expect(failTestReporter.messages).To(BeEmpty())
+		Expect(keys).To(Equal([]string{"A", "B", "A", "B", "A", "B"}))
+	})
+
+	It("reports the failures of the stubs with the run they happened in", func() {
+		sandbox.stubs[2].ReturnsInOrder([]interface{}{1}).WhenExhausted(FailTest)
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			_ = count()
+			_ = count()
+		})
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: ErrorSweep discovery run: mocka: expected at most 1 calls for ReturnsInOrder, but the function was called 2 times",
+		}))
+		Expect(sandbox.stubs[2].testReporter).ToNot(BeAssignableToTypeOf(&sweepReporter{}))
+	})
+
+	It("starts the sequences of every stub over for each run", func() {
+		sandbox.stubs[2].ReturnsInOrder([]interface{}{1}, []interface{}{2})
+		var counts []int
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			_, _ = fetch("A")
+			counts = append(counts, count())
+		})
+
+		Expect(failTestReporter.messages).To(BeEmpty())
+		Expect(counts).To(Equal([]int{1, 1}))
+	})
+
+	Describe("resetRun", func() {
+		It("rewinds the fault stream and fuzz input of the stub", func() {
+			stub := sandbox.stubs[0]
+			stub.InjectFaults(FailWith(errors.New("Ope"), 0.5, 42))
+			FuzzReturns(stub, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+			run := func() []interface{} {
+				var results []interface{}
+				for i := 0; i < 8; i++ {
+					value, err := fetch("A")
+					results = append(results, value, err)
+				}
+
+				return results
+			}
+
+			first := run()
+			stub.resetRun()
+
+			Expect(stub.calls).To(BeEmpty())
+			Expect(run()).To(Equal(first))
+		})
+	})
+
+	It("restores the existing OnCall return values after each run", func() {
+		sandbox.stubs[1].OnCall(0).Return(errors.New("existing"))
+
+		ErrorSweep(failTestReporter, sandbox, func(t TestReporter, injected error) {
+			_ = save("A")
+		})
+
+		Expect(sandbox.stubs[1].onCalls).To(HaveLen(1))
+		Expect(sandbox.stubs[1].onCalls[0].out).To(Equal([]interface{}{errors.New("existing")}))
+	})
+})
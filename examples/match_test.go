@@ -1,8 +1,16 @@
 package examples
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/Bayer-Group/mocka/v2"
 	"github.com/Bayer-Group/mocka/v2/match"
@@ -48,6 +56,74 @@ func ExampleAnythingButNil() {
 	// 5
 }
 
+func ExampleConsistsOf() {
+	var fn = func(ids []int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ConsistsOf(1, match.GreaterThan(2))).Return(20)
+
+	fmt.Println(fn([]int{1, 2}))
+	fmt.Println(fn([]int{5, 1}))
+	// Output: 10
+	// 20
+}
+
+func ExampleContainsElementMatching() {
+	var fn = func(files []string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ContainsElementMatching(match.StringSuffix(".go"))).Return(20)
+
+	fmt.Println(fn([]string{"README.md"}))
+	fmt.Println(fn([]string{"README.md", "main.go"}))
+	// Output: 10
+	// 20
+}
+
+func ExampleContextDeadlineWithin() {
+	var fn = func(ctx context.Context) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ContextDeadlineWithin(5 * time.Second)).Return(20)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	fmt.Println(fn(context.Background()))
+	fmt.Println(fn(ctx))
+	// Output: 10
+	// 20
+}
+
+func ExampleContextWithValue() {
+	type traceIDKey struct{}
+	var fn = func(ctx context.Context, id int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ContextWithValue(traceIDKey{}, "trace-1"), 1).Return(20)
+
+	fmt.Println(fn(context.Background(), 1))
+	fmt.Println(fn(context.WithValue(context.Background(), traceIDKey{}, "trace-1"), 1))
+	// Output: 10
+	// 20
+}
+
 func ExampleConvertibleTo() {
 	var fn = func(x int, y int) int {
 		return x + y
@@ -105,6 +181,73 @@ func ExampleEmpty() {
 	// 10
 }
 
+func ExampleErrorAs() {
+	var fn = func(err error) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	var target *os.PathError
+	stub.WithArgs(match.ErrorAs(&target)).Return(20)
+
+	fmt.Println(fn(io.EOF))
+	fmt.Println(fn(fmt.Errorf("reading config: %w", &os.PathError{Op: "open", Path: "config.yml", Err: os.ErrNotExist})))
+	fmt.Println(target.Path)
+	// Output: 10
+	// 20
+	// config.yml
+}
+
+func ExampleErrorContaining() {
+	var fn = func(err error) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ErrorContaining("timeout")).Return(20)
+
+	fmt.Println(fn(io.EOF))
+	fmt.Println(fn(errors.New("dial tcp: i/o timeout")))
+	// Output: 10
+	// 20
+}
+
+func ExampleErrorIs() {
+	var fn = func(err error) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ErrorIs(io.EOF)).Return(20)
+
+	fmt.Println(fn(io.ErrUnexpectedEOF))
+	fmt.Println(fn(fmt.Errorf("reading: %w", io.EOF)))
+	// Output: 10
+	// 20
+}
+
+func ExampleEvery() {
+	var fn = func(ids []int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Every(match.GreaterThan(0))).Return(20)
+
+	fmt.Println(fn([]int{1, 0}))
+	fmt.Println(fn([]int{1, 2}))
+	// Output: 10
+	// 20
+}
+
 func ExampleExactly() {
 	var fn = func(x int) int {
 		return x
@@ -366,6 +509,46 @@ func ExampleLengthOf() {
 	// 30
 }
 
+func ExampleMapContaining() {
+	var fn = func(payload map[string]interface{}) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.MapContaining(
+		"event", "created",
+		"user", map[string]interface{}{"name": match.StringPrefix("mo")},
+	)).Return(20)
+
+	fmt.Println(fn(map[string]interface{}{"event": "deleted"}))
+	fmt.Println(fn(map[string]interface{}{
+		"event": "created",
+		"user":  map[string]interface{}{"id": 12, "name": "mocka"},
+	}))
+	// Output: 10
+	// 20
+}
+
+func ExampleMapOf() {
+	var fn = func(m map[string]int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.MapOf(map[interface{}]match.SupportedKindsMatcher{
+		"a": match.GreaterThan(0),
+	})).Return(20)
+
+	fmt.Println(fn(map[string]int{"a": 1, "b": 2}))
+	fmt.Println(fn(map[string]int{"a": 1}))
+	// Output: 10
+	// 20
+}
+
 func ExampleNil() {
 	var fn = func(m map[string]struct{}) int {
 		return 0
@@ -398,6 +581,70 @@ func ExampleStringContaining() {
 	// 20
 }
 
+func ExampleStringEqualFold() {
+	var fn = func(s string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StringEqualFold("mocka")).Return(20)
+
+	fmt.Println(fn("mock"))
+	fmt.Println(fn("MoCkA"))
+	// Output: 10
+	// 20
+}
+
+func ExampleStringEqualIgnoringWhitespace() {
+	var fn = func(query string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StringEqualIgnoringWhitespace("SELECT * FROM users")).Return(20)
+
+	fmt.Println(fn("SELECT * FROM orders"))
+	fmt.Println(fn("SELECT *\n\tFROM users"))
+	// Output: 10
+	// 20
+}
+
+func ExampleStringLengthOf() {
+	var fn = func(b []byte) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StringLengthOf(2)).Return(20)
+
+	fmt.Println(fn([]byte("mocka")))
+	fmt.Println(fn([]byte("日本")))
+	// Output: 10
+	// 20
+}
+
+func ExampleStringMatching() {
+	var fn = func(path string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.StringMatching(`^/users/\d+$`)).Return(20)
+
+	fmt.Println(fn("/users/me"))
+	fmt.Println(fn("/users/12"))
+	// Output: 10
+	// 20
+}
+
 func ExampleStringPrefix() {
 	var fn = func(s string) int {
 		return 0
@@ -465,6 +712,265 @@ func ExampleImplementerOf() {
 	// 20
 }
 
+func ExampleAllOf() {
+	var fn = func(s string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.AllOf(match.StringPrefix("/api"), match.Not(match.StringContaining("internal")))).Return(20)
+
+	fmt.Println(fn("/api/internal"))
+	fmt.Println(fn("/api/users"))
+	// Output: 10
+	// 20
+}
+
+func ExampleAnyOf() {
+	var fn = func(s []int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.AnyOf(match.Nil(), match.Empty())).Return(20)
+
+	fmt.Println(fn([]int{1}))
+	fmt.Println(fn(nil))
+	fmt.Println(fn([]int{}))
+	// Output: 10
+	// 20
+	// 20
+}
+
+func ExampleNot() {
+	var fn = func(s string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Not(match.StringPrefix("scr"))).Return(20)
+
+	fmt.Println(fn("screams"))
+	fmt.Println(fn("apples"))
+	// Output: 10
+	// 20
+}
+
+func ExampleOneOf() {
+	var fn = func(method string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.OneOf("GET", "HEAD")).Return(20)
+
+	fmt.Println(fn("POST"))
+	fmt.Println(fn("GET"))
+	fmt.Println(fn("HEAD"))
+	// Output: 10
+	// 20
+	// 20
+}
+
+func ExampleSatisfies() {
+	var fn = func(n int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Satisfies(func(n int) bool { return n%2 == 0 })).Return(20)
+
+	fmt.Println(fn(3))
+	fmt.Println(fn(4))
+	// Output: 10
+	// 20
+}
+
+func ExampleCapture() {
+	var fn = func(id int, callback func(string)) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	var callback func(string)
+	stub.WithArgs(1, match.Capture(&callback)).Return(20)
+
+	fmt.Println(fn(1, func(s string) { fmt.Println("called with", s) }))
+	callback("mocka")
+	// Output: 20
+	// called with mocka
+}
+
+func ExampleCaptureAll() {
+	var fn = func(name string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	var names []string
+	stub.WithArgs("mocka").Return(20)
+	stub.WithArgs(match.CaptureAll(&names)).Return(30)
+
+	fmt.Println(fn("apples"))
+	fmt.Println(fn("mocka"))
+	fmt.Println(fn("screams"))
+	fmt.Println(names)
+	// Output: 30
+	// 20
+	// 30
+	// [apples screams]
+}
+
+func ExampleBetween() {
+	var fn = func(n uint8) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Between(1, 5)).Return(20)
+
+	fmt.Println(fn(0))
+	fmt.Println(fn(5))
+	// Output: 10
+	// 20
+}
+
+func ExampleFields() {
+	type request struct {
+		ID   string
+		Name string
+	}
+
+	var fn = func(r request) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Fields(map[string]match.SupportedKindsMatcher{
+		"Name": match.Exactly("mocka"),
+	})).Return(20)
+
+	fmt.Println(fn(request{ID: "8b0f", Name: "gomock"}))
+	fmt.Println(fn(request{ID: "2c9a", Name: "mocka"}))
+	// Output: 10
+	// 20
+}
+
+func ExampleFinite() {
+	var fn = func(f float64) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Finite()).Return(20)
+
+	fmt.Println(fn(math.Inf(1)))
+	fmt.Println(fn(1.5))
+	// Output: 10
+	// 20
+}
+
+func ExampleGreaterThan() {
+	type UserID int64
+	var fn = func(id UserID) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.GreaterThan(100)).Return(20)
+
+	fmt.Println(fn(UserID(100)))
+	fmt.Println(fn(UserID(101)))
+	// Output: 10
+	// 20
+}
+
+func ExampleInDelta() {
+	var fn = func(f float64) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.InDelta(0.3, 0.0001)).Return(20)
+
+	fmt.Println(fn(0.4))
+	fmt.Println(fn(0.1 + 0.2))
+	// Output: 10
+	// 20
+}
+
+func ExampleLessThan() {
+	var fn = func(n uint) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.LessThan(5)).Return(20)
+
+	fmt.Println(fn(5))
+	fmt.Println(fn(4))
+	// Output: 10
+	// 20
+}
+
+func ExampleNaN() {
+	var fn = func(f float64) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.NaN()).Return(20)
+
+	fmt.Println(fn(1.5))
+	fmt.Println(fn(math.NaN()))
+	// Output: 10
+	// 20
+}
+
+func ExampleValuesContaining() {
+	var fn = func(m map[string]int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ValuesContaining(2, match.GreaterThan(5))).Return(20)
+
+	fmt.Println(fn(map[string]int{"a": 2}))
+	fmt.Println(fn(map[string]int{"a": 2, "b": 6}))
+	// Output: 10
+	// 20
+}
+
 type mockMatcher struct {
 }
 
@@ -477,3 +983,262 @@ func (mockMatcher) SupportedKinds() map[reflect.Kind]struct{} {
 func (mockMatcher) Match(interface{}) bool {
 	return false
 }
+
+func ExampleDurationBetween() {
+	var fn = func(key string, ttl time.Duration) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Anything(), match.DurationBetween(time.Minute, 5*time.Minute)).Return(20)
+
+	fmt.Println(fn("key", time.Hour))
+	fmt.Println(fn("key", 2*time.Minute))
+	// Output: 10
+	// 20
+}
+
+func ExampleTimeAfter() {
+	var fn = func(t time.Time) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	now := time.Now()
+	stub.WithArgs(match.TimeAfter(now)).Return(20)
+
+	fmt.Println(fn(now))
+	fmt.Println(fn(now.Add(time.Second)))
+	// Output: 10
+	// 20
+}
+
+func ExampleTimeBefore() {
+	var fn = func(t *time.Time) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	now := time.Now()
+	earlier := now.Add(-time.Second)
+	stub.WithArgs(match.TimeBefore(now)).Return(20)
+
+	fmt.Println(fn(&now))
+	fmt.Println(fn(&earlier))
+	// Output: 10
+	// 20
+}
+
+func ExampleTimeWithin() {
+	var fn = func(t time.Time) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	expiry := time.Now().Add(time.Hour)
+	stub.WithArgs(match.TimeWithin(expiry, time.Second)).Return(20)
+
+	fmt.Println(fn(expiry.Add(time.Minute)))
+	fmt.Println(fn(expiry.Add(time.Millisecond).Round(0)))
+	// Output: 10
+	// 20
+}
+
+func ExampleTimeZone() {
+	var fn = func(t time.Time) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.TimeZone(time.UTC)).Return(20)
+
+	fmt.Println(fn(time.Date(2021, 1, 1, 0, 0, 0, 0, time.FixedZone("EST", -5*60*60))))
+	fmt.Println(fn(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)))
+	// Output: 10
+	// 20
+}
+
+func ExamplePointsTo() {
+	var fn = func(n *int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.PointsTo(match.GreaterThan(0))).Return(20)
+
+	negative, positive := -1, 1
+	fmt.Println(fn(nil))
+	fmt.Println(fn(&negative))
+	fmt.Println(fn(&positive))
+	// Output: 10
+	// 10
+	// 20
+}
+
+func ExampleSame() {
+	var fn = func(m map[string]int) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	cache := map[string]int{"a": 1}
+	stub.WithArgs(match.Same(cache)).Return(20)
+
+	fmt.Println(fn(map[string]int{"a": 1}))
+	fmt.Println(fn(cache))
+	// Output: 10
+	// 20
+}
+
+func ExampleBytesContaining() {
+	var fn = func(payload []byte) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.BytesContaining([]byte("mocka"))).Return(20)
+
+	fmt.Println(fn([]byte("gomock")))
+	fmt.Println(fn([]byte("hello mocka")))
+	// Output: 10
+	// 20
+}
+
+func ExampleBytesEqual() {
+	var fn = func(key string, value []byte) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs("key", match.BytesEqual(nil)).Return(20)
+
+	fmt.Println(fn("key", []byte("value")))
+	fmt.Println(fn("key", []byte{}))
+	// Output: 10
+	// 20
+}
+
+func ExampleJSONEq() {
+	var fn = func(body string) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.JSONEq(map[string]interface{}{"id": 7, "name": "mocka"})).Return(20)
+
+	fmt.Println(fn(`{"id": 8, "name": "mocka"}`))
+	fmt.Println(fn(`{"name": "mocka", "id": 7}`))
+	// Output: 10
+	// 20
+}
+
+func ExampleJSONPath() {
+	var fn = func(body []byte) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.JSONPath("items.0.id", match.GreaterThan(100))).Return(20)
+
+	fmt.Println(fn([]byte(`{"items": [{"id": 42}]}`)))
+	fmt.Println(fn([]byte(`{"items": [{"id": 420}]}`)))
+	// Output: 10
+	// 20
+}
+
+func ExampleReaderContent() {
+	var fn = func(r io.Reader) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.ReaderContent(match.JSONEq(`{"event": "created"}`))).Return(20)
+
+	reader := strings.NewReader(`{"event": "created"}`)
+	fmt.Println(fn(strings.NewReader(`{"event": "deleted"}`)))
+	fmt.Println(fn(reader))
+
+	content, _ := io.ReadAll(reader)
+	fmt.Println(string(content))
+	// Output: 10
+	// 20
+	// {"event": "created"}
+}
+
+func ExampleHTTPRequest() {
+	var httpDo = func(r *http.Request) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &httpDo, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.HTTPRequest().
+		Method("POST").
+		Path("/v1/items/*").
+		Header("Authorization", match.StringPrefix("Bearer ")).
+		Query("page", "2").
+		Body(match.JSONEq(`{"name": "mocka"}`))).
+		Return(20)
+
+	get, _ := http.NewRequest("GET", "http://localhost/v1/items/42?page=2", nil)
+	get.Header.Set("Authorization", "Bearer token")
+	post, _ := http.NewRequest("POST", "http://localhost/v1/items/42?page=2", strings.NewReader(`{"name": "mocka"}`))
+	post.Header.Set("Authorization", "Bearer token")
+
+	fmt.Println(httpDo(get))
+	fmt.Println(httpDo(post))
+	// Output: 10
+	// 20
+}
+
+func ExampleEqual() {
+	type item struct {
+		Name      string
+		Tags      []string
+		UpdatedAt time.Time
+	}
+
+	var fn = func(items []item) int {
+		return 0
+	}
+
+	stub := mocka.Function(t, &fn, 10)
+	defer stub.Restore()
+
+	stub.WithArgs(match.Equal(
+		[]item{{Name: "a"}, {Name: "b", Tags: []string{}}},
+		match.IgnoreFields("UpdatedAt"),
+		match.EquateEmpty(),
+		match.IgnoreOrder(),
+	)).Return(20)
+
+	fmt.Println(fn([]item{{Name: "a"}, {Name: "c"}}))
+	fmt.Println(fn([]item{{Name: "b"}, {Name: "a", UpdatedAt: time.Now()}}))
+	// Output: 10
+	// 20
+}
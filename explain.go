@@ -0,0 +1,93 @@
+package mocka
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Bayer-Group/mocka/v2/internal/captures"
+	"github.com/Bayer-Group/mocka/v2/match"
+)
+
+// Explain returns a report of how the arguments of the call at the specified
+// call index compared against each WithArgs rule of the stub. For every rule
+// it lists which argument positions matched, why the first one that did not
+// match failed and which ones were not evaluated after it, along with the rule
+// that has the highest priority of the matching rules.
+//
+// The report is built from whether each rule matched when the call was made,
+// so rules added after the call are not included. Only the matcher of the
+// argument that did not match is evaluated again to explain why, and any
+// values it captures while doing so are discarded. Stubs have no strict mode,
+// so the report is never produced automatically.
+func (stub *Stub) Explain(callIndex int) string {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if callIndex < 0 || callIndex >= len(stub.calls) {
+		stub.testReporter.Errorf("mocka: attempted to explain invocation %v, when the function has only been called %v times", callIndex, len(stub.calls))
+		return ""
+	}
+
+	functionType := stub.toType()
+	call := stub.calls[callIndex]
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "mocka: call %v to %v", callIndex, toFriendlyName(functionType))
+	for i, arg := range call.args {
+		fmt.Fprintf(&report, "\n\targ %v (%v): %#v", i+1, toFriendlyName(functionType.In(i)), arg)
+	}
+
+	if len(call.rules) == 0 {
+		report.WriteString("\nthe stub has no WithArgs rules")
+		return report.String()
+	}
+
+	for i, rule := range call.rules {
+		fmt.Fprintf(&report, "\nWithArgs rule %v: (%v)", i, describeMatchers(rule.customArgs.argMatchers))
+		for j, arg := range call.args {
+			fmt.Fprintf(&report, "\n\targ %v: %v", j+1, rule.explain(j, arg))
+		}
+	}
+
+	if call.resolution.Rule >= 0 {
+		fmt.Fprintf(&report, "\nWithArgs rule %v has the highest priority of the matching rules", call.resolution.Rule)
+		return report.String()
+	}
+
+	report.WriteString("\nno WithArgs rule matches, so the call does not use a WithArgs rule")
+	return report.String()
+}
+
+// explain returns how the argument at the index compared against the rule.
+// The argument that did not match is explained by its matcher, which may
+// evaluate it again, so the values the matcher captures are discarded.
+func (r ruleOutcome) explain(index int, arg interface{}) (explanation string) {
+	switch {
+	case r.matched() || index < r.failed:
+		return "matched"
+	case index > r.failed:
+		return "not evaluated, since an earlier argument did not match"
+	case r.reason != "":
+		return r.reason
+	}
+
+	matcher := r.customArgs.argMatchers[index]
+	defer func() {
+		captures.Settle(matcher, false)
+		if p := recover(); p != nil {
+			explanation = fmt.Sprintf("%v panicked: %v", match.Description(matcher), p)
+		}
+	}()
+
+	return match.Explanation(matcher, arg)
+}
+
+// describeMatchers returns the descriptions of the matchers separated by commas
+func describeMatchers(matchers []match.SupportedKindsMatcher) string {
+	descriptions := make([]string, len(matchers))
+	for i, m := range matchers {
+		descriptions[i] = match.Description(m)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,160 @@
+package mocka
+
+import (
+	"github.com/Bayer-Group/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("explain", func() {
+	var (
+		fn               func(string, int) (int, error)
+		stub             *Stub
+		failTestReporter *mockTestReporter
+	)
+
+	BeforeEach(func() {
+		fn = func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+
+		failTestReporter = &mockTestReporter{}
+		stub = newStub(failTestReporter, &fn, []interface{}{42, nil})
+	})
+
+	AfterEach(func() {
+		stub.Restore()
+	})
+
+	Describe("Explain", func() {
+		It("reports an error if the call index is less than 0", func() {
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(-1)
+
+			Expect(result).To(BeEmpty())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: attempted to explain invocation -1, when the function has only been called 1 times",
+			}))
+		})
+
+		It("reports an error if the call index is greater than the number of calls", func() {
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(1)
+
+			Expect(result).To(BeEmpty())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: attempted to explain invocation 1, when the function has only been called 1 times",
+			}))
+		})
+
+		It("explains a call to a stub without rules", func() {
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(0)
+
+			Expect(result).To(Equal("mocka: call 0 to func(string, int) (int, error) {}" +
+				"\n\targ 1 (string): \"hello\"" +
+				"\n\targ 2 (int): 1" +
+				"\nthe stub has no WithArgs rules"))
+		})
+
+		It("explains why each rule did not match the call", func() {
+			stub.WithArgs("sam", match.GreaterThan(10)).Return(1, nil)
+			stub.WithArgs(match.StringPrefix("he"), 2).Return(2, nil)
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(0)
+
+			Expect(failTestReporter.messages).To(BeEmpty())
+			Expect(result).To(Equal("mocka: call 0 to func(string, int) (int, error) {}" +
+				"\n\targ 1 (string): \"hello\"" +
+				"\n\targ 2 (int): 1" +
+				"\nWithArgs rule 0: (exactly \"sam\", number greater than 10)" +
+				"\n\targ 1: expected exactly \"sam\", got \"hello\"" +
+				"\n\targ 2: not evaluated, since an earlier argument did not match" +
+				"\nWithArgs rule 1: (string with prefix \"he\", exactly 2)" +
+				"\n\targ 1: matched" +
+				"\n\targ 2: expected exactly 2, got 1" +
+				"\nno WithArgs rule matches, so the call does not use a WithArgs rule"))
+		})
+
+		It("explains which of the matching rules has the highest priority", func() {
+			stub.WithArgs(match.Anything(), 1).Return(1, nil)
+			stub.WithArgs("hello", match.Anything()).Return(2, nil)
+			stub.WithArgs("sam", 1).Return(3, nil)
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(0)
+
+			Expect(result).To(Equal("mocka: call 0 to func(string, int) (int, error) {}" +
+				"\n\targ 1 (string): \"hello\"" +
+				"\n\targ 2 (int): 1" +
+				"\nWithArgs rule 0: (anything, exactly 1)" +
+				"\n\targ 1: matched" +
+				"\n\targ 2: matched" +
+				"\nWithArgs rule 1: (exactly \"hello\", anything)" +
+				"\n\targ 1: matched" +
+				"\n\targ 2: matched" +
+				"\nWithArgs rule 2: (exactly \"sam\", exactly 1)" +
+				"\n\targ 1: expected exactly \"sam\", got \"hello\"" +
+				"\n\targ 2: not evaluated, since an earlier argument did not match" +
+				"\nWithArgs rule 1 has the highest priority of the matching rules"))
+		})
+
+		It("explains matchers that panic", func() {
+			stub.WithArgs(match.Satisfies(func(str string) bool {
+				panic("ope")
+			}), 1).Return(1, nil)
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(0)
+
+			Expect(result).To(ContainSubstring("\n\targ 1: the predicate func(string) bool panicked when matching \"hello\": ope\n"))
+			Expect(result).To(HaveSuffix("\nno WithArgs rule matches, so the call does not use a WithArgs rule"))
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: the predicate func(string) bool panicked when matching \"hello\": ope",
+			}))
+		})
+
+		It("does not evaluate the matchers again", func() {
+			var captured []string
+			stub.WithArgs(match.CaptureAll(&captured), 1).Return(1, nil)
+			_, _ = fn("hello", 1)
+
+			_ = stub.Explain(0)
+			_ = stub.Explain(0)
+
+			Expect(captured).To(Equal([]string{"hello"}))
+		})
+
+		It("discards the values captured while explaining an argument that did not match", func() {
+			var captured []string
+			stub.WithArgs(match.AllOf(match.CaptureAll(&captured), match.StringPrefix("sam")), 1).Return(1, nil)
+			_, _ = fn("hello", 1)
+
+			result := stub.Explain(0)
+			_, _ = fn("sam", 1)
+
+			Expect(result).To(ContainSubstring("\n\targ 1: "))
+			Expect(captured).To(Equal([]string{"sam"}))
+		})
+
+		It("explains the rules as they were when the call was made", func() {
+			stub.WithArgs("hello", 1).Return(1, nil)
+			_, _ = fn("hello", 1)
+			stub.WithArgs(match.Anything(), match.Anything()).Return(2, nil)
+
+			result := stub.Explain(0)
+
+			Expect(result).To(Equal("mocka: call 0 to func(string, int) (int, error) {}" +
+				"\n\targ 1 (string): \"hello\"" +
+				"\n\targ 2 (int): 1" +
+				"\nWithArgs rule 0: (exactly \"hello\", exactly 1)" +
+				"\n\targ 1: matched" +
+				"\n\targ 2: matched" +
+				"\nWithArgs rule 0 has the highest priority of the matching rules"))
+		})
+	})
+})
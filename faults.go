@@ -0,0 +1,147 @@
+package mocka
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// FaultPolicy describes when an error is injected into the trailing
+// error out parameter of a stub
+type FaultPolicy struct {
+	err         error
+	probability float64
+	seed        int64
+	stream      int64
+	interval    bool
+	every       int
+}
+
+// FailWith returns a fault policy that injects the error into a call with
+// the provided probability, from 0 to 1. The seed is used to make the
+// injected faults reproducible; a seed of 0 will use a random seed.
+func FailWith(err error, probability float64, seed int64) FaultPolicy {
+	return FaultPolicy{err: err, probability: probability, seed: seed}
+}
+
+// FailEvery returns a fault policy that injects the error into every nth call
+func FailEvery(n int, err error) FaultPolicy {
+	return FaultPolicy{err: err, interval: true, every: n}
+}
+
+// validate returns true if the policy can be used to inject faults
+// into a function of the provided type; otherwise the failure is reported
+func (p FaultPolicy) validate(testReporter TestReporter, functionType reflect.Type) bool {
+	switch {
+	case errorOutIndex(functionType) == -1:
+		testReporter.Errorf("mocka: expected the last return value to be an error to inject faults, but received %v", toFriendlyName(functionType))
+		return false
+	case p.err == nil:
+		testReporter.Errorf("mocka: expected a non-nil error to inject faults")
+		return false
+	case p.interval && p.every <= 0:
+		testReporter.Errorf("mocka: expected a positive call interval to inject faults, but received %v", p.every)
+		return false
+	case !p.interval && (p.probability < 0 || p.probability > 1):
+		testReporter.Errorf("mocka: expected a fault probability between 0 and 1 to inject faults, but received %v", p.probability)
+		return false
+	default:
+		return true
+	}
+}
+
+// faultInjector decides which calls of a single stub have a fault injected
+type faultInjector struct {
+	policy FaultPolicy
+	seed   int64
+	random *rand.Rand
+}
+
+// newFaultInjector constructor function for faultInjector. The random faults
+// are derived from the seed and the stream of the policy, so stubs sharing a
+// seed do not fail on the same calls.
+func newFaultInjector(policy FaultPolicy) *faultInjector {
+	policy = policy.withSeed()
+	return &faultInjector{policy: policy, seed: policy.seed, random: rand.New(rand.NewSource(streamSeed(policy.seed, policy.stream)))}
+}
+
+// streamSeed mixes the seed with the stream using the splitmix64 finalizer,
+// so different seeds and streams do not share the same random faults
+func streamSeed(seed int64, stream int64) int64 {
+	z := uint64(seed) ^ uint64(stream)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// withSeed returns the policy with a random seed if no seed was provided
+func (p FaultPolicy) withSeed() FaultPolicy {
+	if p.seed == 0 {
+		p.seed = time.Now().UnixNano()
+	}
+
+	return p
+}
+
+// withStream returns the policy using the stream of random faults for a stub,
+// so every stub of a sandbox gets its own stream from the same seed
+func (p FaultPolicy) withStream(stream int64) FaultPolicy {
+	p.stream = stream
+	return p
+}
+
+// fault returns the error to inject for the call index; otherwise nil
+func (f *faultInjector) fault(callIndex int) error {
+	if f.policy.interval {
+		if (callIndex+1)%f.policy.every == 0 {
+			return f.policy.err
+		}
+
+		return nil
+	}
+
+	if f.random.Float64() < f.policy.probability {
+		return f.policy.err
+	}
+
+	return nil
+}
+
+// failureReporter describes a test reporter that can report the
+// fault injection seed once the test has failed
+type failureReporter interface {
+	Failed() bool
+	Cleanup(func())
+}
+
+// logReporter describes a test reporter that can log messages
+type logReporter interface {
+	Logf(string, ...interface{})
+}
+
+// reportSeedOnFailure reports the seed used to inject faults if the test fails,
+// so the test run can be reproduced
+func reportSeedOnFailure(testReporter TestReporter, functionType reflect.Type, injector *faultInjector) {
+	if injector.policy.interval {
+		return
+	}
+
+	reporter, ok := testReporter.(failureReporter)
+	if !ok {
+		return
+	}
+
+	reporter.Cleanup(func() {
+		if !reporter.Failed() {
+			return
+		}
+
+		const message = "mocka: faults were injected into %v using the seed %v"
+		if logger, ok := testReporter.(logReporter); ok {
+			logger.Logf(message, toFriendlyName(functionType), injector.seed)
+			return
+		}
+
+		testReporter.Errorf(message, toFriendlyName(functionType), injector.seed)
+	})
+}
@@ -0,0 +1,153 @@
+package mocka
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// mockFailureReporter used to simulate a test reporter that
+// supports cleanup functions and logging
+type mockFailureReporter struct {
+	mockTestReporter
+	failed   bool
+	logs     []string
+	cleanups []func()
+}
+
+// Failed returns whether or not the test has been marked as failed
+func (m *mockFailureReporter) Failed() bool {
+	return m.failed
+}
+
+// Cleanup appends the function to the internal cleanups slice
+func (m *mockFailureReporter) Cleanup(fn func()) {
+	m.cleanups = append(m.cleanups, fn)
+}
+
+// Logf appends the message to the internal logs slice
+func (m *mockFailureReporter) Logf(f string, args ...interface{}) {
+	m.logs = append(m.logs, fmt.Sprintf(f, args...))
+}
+
+var _ = Describe("faults", func() {
+	var functionType reflect.Type
+
+	BeforeEach(func() {
+		functionType = reflect.TypeOf(func(string) (int, error) { return 0, nil })
+	})
+
+	DescribeTable("validate reports an error",
+		func(policy FaultPolicy, fn interface{}, expected string) {
+			failTestReporter := &mockTestReporter{}
+
+			Expect(policy.validate(failTestReporter, reflect.TypeOf(fn))).To(BeFalse())
+			Expect(failTestReporter.messages).To(Equal([]string{expected}))
+		},
+		Entry("when the function does not return an error", FailEvery(1, errors.New("Ope")), func() {},
+			"mocka: expected the last return value to be an error to inject faults, but received func() {}"),
+		Entry("when the error is nil", FailEvery(1, nil), func() error { return nil },
+			"mocka: expected a non-nil error to inject faults"),
+		Entry("when the probability is greater than 1", FailWith(errors.New("Ope"), 1.5, 1), func() error { return nil },
+			"mocka: expected a fault probability between 0 and 1 to inject faults, but received 1.5"),
+		Entry("when the probability is negative", FailWith(errors.New("Ope"), -0.5, 1), func() error { return nil },
+			"mocka: expected a fault probability between 0 and 1 to inject faults, but received -0.5"),
+		Entry("when the interval is negative", FailEvery(-1, errors.New("Ope")), func() error { return nil },
+			"mocka: expected a positive call interval to inject faults, but received -1"),
+		Entry("when the interval is zero", FailEvery(0, errors.New("Ope")), func() error { return nil },
+			"mocka: expected a positive call interval to inject faults, but received 0"),
+	)
+
+	Describe("newFaultInjector", func() {
+		It("uses the provided seed", func() {
+			Expect(newFaultInjector(FailWith(errors.New("Ope"), 0.5, 42)).seed).To(Equal(int64(42)))
+		})
+
+		It("picks a seed when none is provided", func() {
+			Expect(newFaultInjector(FailWith(errors.New("Ope"), 0.5, 0)).seed).ToNot(BeZero())
+		})
+
+		It("keeps the seed for every stream", func() {
+			Expect(newFaultInjector(FailWith(errors.New("Ope"), 0.5, 42).withStream(3)).seed).To(Equal(int64(42)))
+		})
+
+		It("injects different faults for each stream of the same seed", func() {
+			first := newFaultInjector(FailWith(errors.New("Ope"), 0.5, 42).withStream(0))
+			second := newFaultInjector(FailWith(errors.New("Ope"), 0.5, 42).withStream(1))
+
+			firstFaults := make([]bool, 32)
+			secondFaults := make([]bool, 32)
+			for i := range firstFaults {
+				firstFaults[i] = first.fault(i) != nil
+				secondFaults[i] = second.fault(i) != nil
+			}
+
+			Expect(firstFaults).ToNot(Equal(secondFaults))
+		})
+
+		It("injects different faults for nearby seeds and streams", func() {
+			first := newFaultInjector(FailWith(errors.New("Ope"), 0.5, 42).withStream(1))
+			second := newFaultInjector(FailWith(errors.New("Ope"), 0.5, 43).withStream(0))
+
+			firstFaults := make([]bool, 32)
+			secondFaults := make([]bool, 32)
+			for i := range firstFaults {
+				firstFaults[i] = first.fault(i) != nil
+				secondFaults[i] = second.fault(i) != nil
+			}
+
+			Expect(firstFaults).ToNot(Equal(secondFaults))
+		})
+	})
+
+	DescribeTable("fault",
+		func(policy FaultPolicy, expected []bool) {
+			injector := newFaultInjector(policy)
+
+			actual := make([]bool, len(expected))
+			for i := range actual {
+				actual[i] = injector.fault(i) != nil
+			}
+
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("every third call", FailEvery(3, errors.New("Ope")), []bool{false, false, true, false, false, true}),
+		Entry("always with a probability of 1", FailWith(errors.New("Ope"), 1, 7), []bool{true, true, true}),
+		Entry("never with a probability of 0", FailWith(errors.New("Ope"), 0, 7), []bool{false, false, false}),
+	)
+
+	Describe("reportSeedOnFailure", func() {
+		It("logs the seed when the test fails", func() {
+			reporter := &mockFailureReporter{failed: true}
+
+			reportSeedOnFailure(reporter, functionType, newFaultInjector(FailWith(errors.New("Ope"), 0.5, 99)))
+			Expect(reporter.cleanups).To(HaveLen(1))
+			reporter.cleanups[0]()
+
+			Expect(reporter.logs).To(Equal([]string{
+				"mocka: faults were injected into func(string) (int, error) {} using the seed 99",
+			}))
+		})
+
+		It("does not log the seed when the test passes", func() {
+			reporter := &mockFailureReporter{}
+
+			reportSeedOnFailure(reporter, functionType, newFaultInjector(FailWith(errors.New("Ope"), 0.5, 99)))
+			reporter.cleanups[0]()
+
+			Expect(reporter.logs).To(BeEmpty())
+		})
+
+		It("does not report deterministic policies", func() {
+			reporter := &mockFailureReporter{failed: true}
+
+			reportSeedOnFailure(reporter, functionType, newFaultInjector(FailEvery(2, errors.New("Ope"))))
+
+			Expect(reporter.cleanups).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,195 @@
+package mocka
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+)
+
+const (
+	// maxFuzzDepth limits how deep nested types are decoded from fuzz input
+	maxFuzzDepth = 4
+	// maxFuzzLength limits the length of decoded strings, slices, and maps
+	maxFuzzLength = 16
+)
+
+// FuzzReturns makes the stub return values decoded from the fuzz input instead
+// of its default return values. Every call decodes the next values from the
+// input, so the same input always produces the same return values. The bytes
+// used for each call are recorded on the Call.
+//
+// Return values set with OnCall or WithArgs still take precedence over the
+// decoded values.
+func FuzzReturns(stub *Stub, data []byte) {
+	if stub == nil {
+		return
+	}
+
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.fuzz = &fuzzDecoder{data: data}
+}
+
+// FuzzReturns makes every stub in the sandbox return values decoded from the
+// fuzz input instead of its default return values. This includes stubs created
+// after the call.
+func (s *Sandbox) FuzzReturns(data []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.fuzzInput = data
+	for _, stub := range s.stubs {
+		FuzzReturns(stub, data)
+	}
+}
+
+// fuzzDecoder deterministically decodes values from fuzz input
+type fuzzDecoder struct {
+	data   []byte
+	offset int
+	last   []byte
+}
+
+// next decodes the out parameters for the function type and records
+// the bytes that were used
+func (d *fuzzDecoder) next(functionType reflect.Type) []interface{} {
+	start := d.offset
+
+	out := make([]interface{}, functionType.NumOut())
+	for i := range out {
+		value := d.decode(functionType.Out(i), 0)
+		if value.Kind() == reflect.Interface && value.IsNil() {
+			continue
+		}
+
+		out[i] = value.Interface()
+	}
+
+	d.last = append([]byte(nil), d.data[start:d.offset]...)
+	return out
+}
+
+// readBytes returns the next n bytes of the input, padded with zeros
+// once the input has been exhausted
+func (d *fuzzDecoder) readBytes(n int) []byte {
+	b := make([]byte, 8)
+	if n > 8 {
+		n = 8
+	}
+
+	for i := 0; i < n && d.offset < len(d.data); i++ {
+		b[i] = d.data[d.offset]
+		d.offset++
+	}
+
+	return b
+}
+
+// readUint returns the next n bytes of the input as an unsigned integer
+func (d *fuzzDecoder) readUint(n int) uint64 {
+	return binary.LittleEndian.Uint64(d.readBytes(n))
+}
+
+// readLength returns a length between 0 and maxFuzzLength
+func (d *fuzzDecoder) readLength() int {
+	return int(d.readUint(1) % (maxFuzzLength + 1))
+}
+
+// readString returns the next string of the input
+func (d *fuzzDecoder) readString() string {
+	n := d.readLength()
+	if remaining := len(d.data) - d.offset; n > remaining {
+		n = remaining
+	}
+
+	s := string(d.data[d.offset : d.offset+n])
+	d.offset += n
+	return s
+}
+
+// decode returns a new value of the provided type decoded from the input
+func (d *fuzzDecoder) decode(t reflect.Type, depth int) reflect.Value {
+	v := reflect.New(t).Elem()
+	if depth > maxFuzzDepth {
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(d.readUint(1)&1 == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(d.readUint(int(t.Size()))))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(d.readUint(int(t.Size())))
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(uint32(d.readUint(4)))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(d.readUint(8)))
+	case reflect.Complex64:
+		v.SetComplex(complex(float64(math.Float32frombits(uint32(d.readUint(4)))), float64(math.Float32frombits(uint32(d.readUint(4))))))
+	case reflect.Complex128:
+		v.SetComplex(complex(math.Float64frombits(d.readUint(8)), math.Float64frombits(d.readUint(8))))
+	case reflect.String:
+		v.SetString(d.readString())
+	case reflect.Slice:
+		n := d.readLength()
+		if n == 0 {
+			return v
+		}
+
+		v.Set(reflect.MakeSlice(t, n-1, n-1))
+		for i := 0; i < n-1; i++ {
+			v.Index(i).Set(d.decode(t.Elem(), depth+1))
+		}
+	case reflect.Array:
+		for i := 0; i < t.Len(); i++ {
+			v.Index(i).Set(d.decode(t.Elem(), depth+1))
+		}
+	case reflect.Map:
+		n := d.readLength()
+		if n == 0 {
+			return v
+		}
+
+		v.Set(reflect.MakeMap(t))
+		for i := 0; i < n-1; i++ {
+			v.SetMapIndex(d.decode(t.Key(), depth+1), d.decode(t.Elem(), depth+1))
+		}
+	case reflect.Ptr:
+		if d.readUint(1)&1 == 0 {
+			return v
+		}
+
+		elem := reflect.New(t.Elem())
+		elem.Elem().Set(d.decode(t.Elem(), depth+1))
+		v.Set(elem)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if field := v.Field(i); field.CanSet() {
+				field.Set(d.decode(t.Field(i).Type, depth+1))
+			}
+		}
+	case reflect.Interface:
+		d.decodeInterface(v)
+	}
+
+	return v
+}
+
+// decodeInterface assigns a value to errors and empty interfaces, leaving
+// them nil when the input decides so. Other interfaces are left nil as no
+// implementation is known.
+func (d *fuzzDecoder) decodeInterface(v reflect.Value) {
+	switch {
+	case v.Type() == errorType:
+		if d.readUint(1)&1 == 1 {
+			v.Set(reflect.ValueOf(errors.New(d.readString())))
+		}
+	case v.NumMethod() == 0:
+		if d.readUint(1)&1 == 1 {
+			v.Set(reflect.ValueOf(d.readString()))
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package mocka
+
+import (
+	"errors"
+	"math"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+type fuzzStruct struct {
+	Name     string
+	Count    uint16
+	Next     *fuzzStruct
+	internal int
+}
+
+var _ = Describe("fuzz", func() {
+	Describe("FuzzReturns", func() {
+		It("does nothing for a nil stub", func() {
+			Expect(func() { FuzzReturns(nil, []byte{1}) }).ToNot(Panic())
+		})
+
+		It("returns decoded values and records the input on each call", func() {
+			fn := func(string) (int32, error) { return 0, nil }
+			stub := newStub(GinkgoT(), &fn, []interface{}{int32(42), nil})
+			defer stub.Restore()
+
+			FuzzReturns(stub, []byte{1, 0, 0, 0, 1, 3, 'O', 'p', 'e', 2, 0, 0, 0, 0})
+
+			n, err := fn("A")
+			Expect(n).To(Equal(int32(1)))
+			Expect(err).To(MatchError("Ope"))
+			Expect(stub.GetFirstCall().FuzzInput()).To(Equal([]byte{1, 0, 0, 0, 1, 3, 'O', 'p', 'e'}))
+
+			n, err = fn("A")
+			Expect(n).To(Equal(int32(2)))
+			Expect(err).To(BeNil())
+			Expect(stub.GetSecondCall().FuzzInput()).To(Equal([]byte{2, 0, 0, 0, 0}))
+
+			n, err = fn("A")
+			Expect(n).To(BeZero())
+			Expect(err).To(BeNil())
+			Expect(stub.GetThirdCall().FuzzInput()).To(BeEmpty())
+		})
+
+		It("uses the OnCall and custom argument return values first", func() {
+			fn := func(string) int { return 0 }
+			stub := newStub(GinkgoT(), &fn, []interface{}{42})
+			defer stub.Restore()
+
+			FuzzReturns(stub, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9})
+			stub.WithArgs("custom").Return(7)
+
+			Expect(fn("custom")).To(Equal(7))
+			Expect(fn("A")).ToNot(Equal(42))
+		})
+	})
+
+	Describe("Sandbox.FuzzReturns", func() {
+		It("applies the fuzz input to every stub in the sandbox", func() {
+			fn1 := func() uint8 { return 0 }
+			fn2 := func() string { return "" }
+			sandbox := &Sandbox{testReporter: GinkgoT()}
+			defer sandbox.Restore()
+
+			stub1 := sandbox.Function(&fn1, uint8(0))
+			sandbox.FuzzReturns([]byte{3, 'a', 'b', 'c'})
+			stub2 := sandbox.Function(&fn2, "")
+
+			Expect(stub1.fuzz).ToNot(BeNil())
+			Expect(stub2.fuzz).ToNot(BeNil())
+			Expect(fn1()).To(Equal(uint8(3)))
+			Expect(fn2()).To(Equal("abc"))
+		})
+	})
+
+	DescribeTable("decode",
+		func(value interface{}, data []byte, expected interface{}) {
+			decoder := &fuzzDecoder{data: data}
+
+			actual := decoder.decode(reflect.TypeOf(value).Elem(), 0)
+
+			Expect(actual.Interface()).To(Equal(expected))
+		},
+		Entry("bool", new(bool), []byte{1}, true),
+		Entry("int8", new(int8), []byte{0xff}, int8(-1)),
+		Entry("int64", new(int64), []byte{1, 1}, int64(257)),
+		Entry("uint16", new(uint16), []byte{0, 1}, uint16(256)),
+		Entry("float32", new(float32), []byte{0, 0, 0x80, 0x3f}, float32(1)),
+		Entry("complex128", new(complex128), []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, complex(1, 0)),
+		Entry("string", new(string), []byte{2, 'h', 'i', '!'}, "hi"),
+		Entry("string longer than the input", new(string), []byte{5, 'h'}, "h"),
+		Entry("nil slice", new([]int8), []byte{0}, []int8(nil)),
+		Entry("slice", new([]int8), []byte{3, 1, 2}, []int8{1, 2}),
+		Entry("array", new([2]uint8), []byte{4, 5}, [2]uint8{4, 5}),
+		Entry("nil map", new(map[uint8]bool), []byte{0}, map[uint8]bool(nil)),
+		Entry("map", new(map[uint8]bool), []byte{2, 7, 1}, map[uint8]bool{7: true}),
+		Entry("nil pointer", new(*uint8), []byte{0}, (*uint8)(nil)),
+		Entry("non-nil error", new(error), []byte{1, 3, 'O', 'p', 'e'}, errors.New("Ope")),
+		Entry("empty interface", new(interface{}), []byte{1, 1, 'A'}, "A"),
+		Entry("func", new(func()), []byte{1}, (func())(nil)),
+		Entry("struct with exported fields", new(fuzzStruct), []byte{1, 'A', 2, 0, 0}, fuzzStruct{Name: "A", Count: 2}),
+	)
+
+	DescribeTable("decode leaves interfaces nil",
+		func(value interface{}, data []byte) {
+			decoder := &fuzzDecoder{data: data}
+
+			actual := decoder.decode(reflect.TypeOf(value).Elem(), 0)
+
+			Expect(actual.IsNil()).To(BeTrue())
+		},
+		Entry("when the input decides the error is nil", new(error), []byte{0}),
+		Entry("when the interface is not empty", new(reflect.Type), []byte{1}),
+	)
+
+	It("decodes pointers up to the max depth", func() {
+		data := make([]byte, 64)
+		for i := range data {
+			data[i] = 1
+		}
+		decoder := &fuzzDecoder{data: data}
+
+		actual := decoder.decode(reflect.TypeOf(fuzzStruct{}), 0).Interface().(fuzzStruct)
+
+		Expect(actual.Next).ToNot(BeNil())
+		Expect(actual.Next.Next).ToNot(BeNil())
+		Expect(actual.Next.Next.Next).To(BeNil())
+	})
+
+	It("decodes non-finite floats", func() {
+		decoder := &fuzzDecoder{data: []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x7f}}
+
+		actual := decoder.decode(reflect.TypeOf(float64(0)), 0).Float()
+
+		Expect(math.IsInf(actual, 1)).To(BeTrue())
+	})
+})
@@ -0,0 +1,122 @@
+package mocka
+
+import "sync"
+
+// Gate describes a controller that parks calls to a stub until they are released.
+// It is used to orchestrate concurrent calls deterministically.
+type Gate struct {
+	lock sync.Mutex
+
+	restored <-chan struct{}
+	parked   []chan struct{}
+	permits  int
+	changed  chan struct{}
+}
+
+// newGate constructor function for Gate
+func newGate(restored <-chan struct{}) *Gate {
+	return &Gate{restored: restored, changed: make(chan struct{})}
+}
+
+// park blocks the caller until it is released by the gate or the stub is restored
+func (g *Gate) park() {
+	g.lock.Lock()
+	if g.isRestored() {
+		g.lock.Unlock()
+		return
+	}
+
+	if g.permits > 0 {
+		g.permits--
+		g.lock.Unlock()
+		return
+	}
+
+	release := make(chan struct{})
+	g.parked = append(g.parked, release)
+	g.notify()
+	g.lock.Unlock()
+
+	select {
+	case <-release:
+	case <-g.restored:
+	}
+}
+
+// isRestored returns true if the stub of the gate has been restored
+func (g *Gate) isRestored() bool {
+	select {
+	case <-g.restored:
+		return true
+	default:
+		return false
+	}
+}
+
+// notify wakes up anything waiting on a change to the parked callers.
+//
+// The gate must be locked when calling notify.
+func (g *Gate) notify() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// Parked returns the number of callers currently parked by the gate
+func (g *Gate) Parked() int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return len(g.parked)
+}
+
+// Wait blocks until at least n callers are parked by the gate,
+// or the stub is restored
+func (g *Gate) Wait(n int) {
+	for {
+		g.lock.Lock()
+		if len(g.parked) >= n {
+			g.lock.Unlock()
+			return
+		}
+		changed := g.changed
+		g.lock.Unlock()
+
+		select {
+		case <-changed:
+		case <-g.restored:
+			return
+		}
+	}
+}
+
+// Release releases k callers in the order they were parked. If fewer than k
+// callers are parked, the remaining releases are used by the next callers
+// to arrive at the gate.
+func (g *Gate) Release(k int) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for ; k > 0 && len(g.parked) > 0; k-- {
+		close(g.parked[0])
+		g.parked = g.parked[1:]
+	}
+
+	if k > 0 {
+		g.permits += k
+	}
+
+	g.notify()
+}
+
+// ReleaseAll releases every caller currently parked by the gate
+func (g *Gate) ReleaseAll() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for _, release := range g.parked {
+		close(release)
+	}
+
+	g.parked = nil
+	g.notify()
+}
@@ -0,0 +1,161 @@
+package mocka
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gate", func() {
+	var (
+		restored chan struct{}
+		gate     *Gate
+	)
+
+	BeforeEach(func() {
+		restored = make(chan struct{})
+		gate = newGate(restored)
+	})
+
+	parkCallers := func(n int, released chan<- int) {
+		for i := 0; i < n; i++ {
+			i := i
+			go func() {
+				gate.park()
+				released <- i
+			}()
+			gate.Wait(i + 1)
+		}
+	}
+
+	Describe("park", func() {
+		It("does not park callers once the stub is restored", func() {
+			close(restored)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				gate.park()
+			}()
+
+			Eventually(done).Should(BeClosed())
+			Expect(gate.Parked()).To(Equal(0))
+		})
+	})
+
+	Describe("Wait", func() {
+		It("blocks until n callers are parked", func() {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				gate.Wait(2)
+			}()
+
+			go gate.park()
+			Consistently(done, 20*time.Millisecond).ShouldNot(BeClosed())
+
+			go gate.park()
+			Eventually(done).Should(BeClosed())
+			Expect(gate.Parked()).To(Equal(2))
+		})
+
+		It("returns when the stub is restored", func() {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				gate.Wait(1)
+			}()
+
+			close(restored)
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	Describe("Release", func() {
+		It("releases the callers in the order they were parked", func() {
+			released := make(chan int, 3)
+			parkCallers(3, released)
+
+			gate.Release(1)
+			Eventually(released).Should(Receive(Equal(0)))
+			Consistently(released, 20*time.Millisecond).ShouldNot(Receive())
+			Expect(gate.Parked()).To(Equal(2))
+
+			gate.Release(1)
+			Eventually(released).Should(Receive(Equal(1)))
+			Expect(gate.Parked()).To(Equal(1))
+		})
+
+		It("lets the next callers through when fewer callers are parked", func() {
+			gate.Release(2)
+
+			gate.park()
+			gate.park()
+
+			Expect(gate.permits).To(Equal(0))
+			Expect(gate.Parked()).To(Equal(0))
+		})
+	})
+
+	Describe("ReleaseAll", func() {
+		It("releases every parked caller", func() {
+			released := make(chan int, 3)
+			parkCallers(3, released)
+
+			gate.ReleaseAll()
+
+			for i := 0; i < 3; i++ {
+				Eventually(released).Should(Receive())
+			}
+			Expect(gate.Parked()).To(Equal(0))
+		})
+	})
+
+	It("parks calls to the stub", func() {
+		fn := func(str string) int {
+			return len(str)
+		}
+		stub := newStub(GinkgoT(), &fn, []interface{}{42})
+		defer stub.Restore()
+
+		stubGate := stub.Gate()
+		Expect(stub.Gate()).To(BeIdenticalTo(stubGate))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = fn("A")
+			}()
+		}
+
+		stubGate.Wait(2)
+		Expect(stub.CallCount()).To(Equal(2))
+
+		stubGate.ReleaseAll()
+		wg.Wait()
+	})
+
+	It("releases parked calls when the stub is restored", func() {
+		fn := func(str string) int {
+			return len(str)
+		}
+		stub := newStub(GinkgoT(), &fn, []interface{}{42})
+		stubGate := stub.Gate()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = fn("A")
+		}()
+
+		stubGate.Wait(1)
+		stub.Restore()
+
+		Eventually(done).Should(BeClosed())
+		Expect(stubGate.Parked()).To(Equal(0))
+	})
+})
@@ -0,0 +1,14 @@
+// Package captures lets stubs hold back the values matched by the capture
+// matchers of the match package until the custom arguments used for a call
+// are known, without making it part of the public API of either package.
+package captures
+
+var (
+	// Hold makes the capture matchers within the matcher hold the values they
+	// match until they are settled. It is set by the match package.
+	Hold func(matcher interface{})
+
+	// Settle stores the values held by the capture matchers within the matcher
+	// if commit is true; otherwise they are dropped. It is set by the match package.
+	Settle func(matcher interface{}, commit bool)
+)
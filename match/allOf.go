@@ -0,0 +1,59 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AllOf returns a new matcher that will match if all of the provided matchers match
+func AllOf(matchers ...SupportedKindsMatcher) SupportedKindsMatcher {
+	return &allOf{matchers}
+}
+
+type allOf struct {
+	matchers []SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by every one of the matchers
+func (m *allOf) SupportedKinds() map[reflect.Kind]struct{} {
+	return intersectKinds(m.matchers)
+}
+
+// Match returns true if all of the matchers match the value; otherwise false
+func (m *allOf) Match(value interface{}) bool {
+	for _, matcher := range m.matchers {
+		if !matcher.Match(value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a description of what the all of matcher expects
+func (m *allOf) String() string {
+	return fmt.Sprintf("all of (%v)", describeMatchers(m.matchers))
+}
+
+// Explain returns the explanation of the first matcher that does not match the value
+func (m *allOf) Explain(value interface{}) string {
+	for _, matcher := range m.matchers {
+		if !matcher.Match(value) {
+			return Explanation(matcher, value)
+		}
+	}
+
+	return explain(m, value)
+}
+
+// priority returns the priority of the most specific matcher
+func (m *allOf) priority() float64 {
+	var highest float64
+	for _, matcher := range m.matchers {
+		if p := Priority(matcher); p > highest {
+			highest = p
+		}
+	}
+
+	return highest
+}
@@ -0,0 +1,60 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("allOf", func() {
+	Describe("AllOf", func() {
+		It("returns an allOf struct", func() {
+			actual := AllOf()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(allOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the intersection of the matchers supported kinds", func() {
+			actual := AllOf(LengthOf(2), StringPrefix("A")).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+				}))
+		})
+
+		It("returns all kinds when there are no matchers", func() {
+			gomega.Expect(AllOf().SupportedKinds()).To(gomega.Equal(Anything().SupportedKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matchers []SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(AllOf(matchers...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when there are no matchers", []SupportedKindsMatcher{}, "A"),
+		Entry("when all matchers match", []SupportedKindsMatcher{StringPrefix("/api"), Not(StringContaining("internal"))}, "/api/users"),
+	)
+
+	DescribeTable("Match returns false",
+		func(matchers []SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(AllOf(matchers...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when one matcher does not match", []SupportedKindsMatcher{StringPrefix("/api"), Not(StringContaining("internal"))}, "/api/internal"),
+		Entry("when no matchers match", []SupportedKindsMatcher{StringPrefix("/api"), StringSuffix("s")}, "/v2/user"),
+	)
+
+	Describe("priority", func() {
+		It("returns the highest priority of the matchers", func() {
+			gomega.Expect(Priority(AllOf(Anything(), StringPrefix("A"), Empty()))).To(gomega.Equal(Priority(StringPrefix("A"))))
+		})
+
+		It("returns 0 when there are no matchers", func() {
+			gomega.Expect(Priority(AllOf())).To(gomega.BeZero())
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AnyOf returns a new matcher that will match if any of the provided matchers match
+func AnyOf(matchers ...SupportedKindsMatcher) SupportedKindsMatcher {
+	return &anyOf{matchers}
+}
+
+type anyOf struct {
+	matchers []SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by any of the matchers
+func (m *anyOf) SupportedKinds() map[reflect.Kind]struct{} {
+	return unionKinds(m.matchers)
+}
+
+// Match returns true if any of the matchers match the value; otherwise false
+func (m *anyOf) Match(value interface{}) bool {
+	for _, matcher := range m.matchers {
+		if matcher.Match(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a description of what the any of matcher expects
+func (m *anyOf) String() string {
+	return fmt.Sprintf("any of (%v)", describeMatchers(m.matchers))
+}
+
+// Explain returns an explanation of why the value does not match the any of matcher
+func (m *anyOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// priority returns the priority of the least specific matcher
+func (m *anyOf) priority() float64 {
+	if len(m.matchers) == 0 {
+		return 0
+	}
+
+	lowest := Priority(m.matchers[0])
+	for _, matcher := range m.matchers[1:] {
+		if p := Priority(matcher); p < lowest {
+			lowest = p
+		}
+	}
+
+	return lowest
+}
@@ -0,0 +1,63 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("anyOf", func() {
+	Describe("AnyOf", func() {
+		It("returns an anyOf struct", func() {
+			actual := AnyOf()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(anyOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the union of the matchers supported kinds", func() {
+			actual := AnyOf(Nil(), Empty()).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Array:     {},
+					reflect.Chan:      {},
+					reflect.Func:      {},
+					reflect.Interface: {},
+					reflect.Map:       {},
+					reflect.Ptr:       {},
+					reflect.Slice:     {},
+					reflect.String:    {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matchers []SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(AnyOf(matchers...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the first matcher matches", []SupportedKindsMatcher{Nil(), Empty()}, nil),
+		Entry("when the last matcher matches", []SupportedKindsMatcher{Nil(), Empty()}, []int{}),
+	)
+
+	DescribeTable("Match returns false",
+		func(matchers []SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(AnyOf(matchers...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when there are no matchers", []SupportedKindsMatcher{}, "A"),
+		Entry("when no matchers match", []SupportedKindsMatcher{Nil(), Empty()}, []int{1}),
+	)
+
+	Describe("priority", func() {
+		It("returns the lowest priority of the matchers", func() {
+			gomega.Expect(Priority(AnyOf(StringPrefix("A"), Empty(), Exactly("B")))).To(gomega.Equal(Priority(Empty())))
+		})
+
+		It("returns 0 when there are no matchers", func() {
+			gomega.Expect(Priority(AnyOf())).To(gomega.BeZero())
+		})
+	})
+})
@@ -46,3 +46,13 @@ func (anything) SupportedKinds() map[reflect.Kind]struct{} {
 func (anything) Match(_ interface{}) bool {
 	return true
 }
+
+// String returns a description of what the anything matcher expects
+func (anything) String() string {
+	return "anything"
+}
+
+// Explain returns an explanation of why the value does not match the anything matcher
+func (m anything) Explain(value interface{}) string {
+	return explain(m, value)
+}
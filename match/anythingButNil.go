@@ -27,3 +27,13 @@ func (anythingButNil) Match(value interface{}) bool {
 	v := reflect.ValueOf(value)
 	return v.IsValid() && !v.IsNil()
 }
+
+// String returns a description of what the anything but nil matcher expects
+func (anythingButNil) String() string {
+	return "anything but nil"
+}
+
+// Explain returns an explanation of why the value does not match the anything but nil matcher
+func (m anythingButNil) Explain(value interface{}) string {
+	return explain(m, value)
+}
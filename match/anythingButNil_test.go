@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("anythingButNil", func() {
@@ -13,7 +13,7 @@ var _ = Describe("anythingButNil", func() {
 		It("returns an anythingButNil struct", func() {
 			actual := AnythingButNil()
 
-			Expect(actual).To(BeAssignableToTypeOf(new(anythingButNil)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(anythingButNil)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("anythingButNil", func() {
 		It("returns all support kinds in go", func() {
 			actual := AnythingButNil().SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Chan:      {},
 					reflect.Func:      {},
@@ -35,7 +35,7 @@ var _ = Describe("anythingButNil", func() {
 
 	DescribeTable("Match returns true",
 		func(value interface{}) {
-			Expect(AnythingButNil().Match(value)).To(BeTrue())
+			gomega.Expect(AnythingButNil().Match(value)).To(gomega.BeTrue())
 		},
 		Entry("with non nil chan", make(chan int)),
 		Entry("with non nil func", func() {}),
@@ -47,7 +47,7 @@ var _ = Describe("anythingButNil", func() {
 
 	DescribeTable("Match returns false",
 		func(value interface{}) {
-			Expect(AnythingButNil().Match(value)).To(BeFalse())
+			gomega.Expect(AnythingButNil().Match(value)).To(gomega.BeFalse())
 		},
 		Entry("with nil chan", (chan int)(nil)),
 		Entry("with nil func", (func())(nil)),
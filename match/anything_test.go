@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("anything", func() {
@@ -13,7 +13,7 @@ var _ = Describe("anything", func() {
 		It("returns an anything struct", func() {
 			actual := Anything()
 
-			Expect(actual).To(BeAssignableToTypeOf(new(anything)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(anything)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("anything", func() {
 		It("returns all support kinds in go", func() {
 			actual := Anything().SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Bool:          {},
 					reflect.Int:           {},
@@ -55,7 +55,7 @@ var _ = Describe("anything", func() {
 
 	DescribeTable("Match returns true",
 		func(input interface{}) {
-			Expect(Anything().Match(input)).To(BeTrue())
+			gomega.Expect(Anything().Match(input)).To(gomega.BeTrue())
 		},
 		Entry("with nil", nil),
 		Entry("with number", 123),
@@ -0,0 +1,55 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Between returns a new matcher that will match numbers between the provided
+// lower and upper bounds, inclusive. Any int, uint or float kind can be matched,
+// including defined types.
+func Between(lower interface{}, upper interface{}) SupportedKindsMatcher {
+	return &between{lower, upper}
+}
+
+type between struct {
+	lower interface{}
+	upper interface{}
+}
+
+// SupportedKinds returns all the int, uint and float kinds if the provided
+// bounds are numbers; otherwise no kinds are supported
+func (m *between) SupportedKinds() map[reflect.Kind]struct{} {
+	_, lowerOk := numericValue(m.lower)
+	_, upperOk := numericValue(m.upper)
+	if !lowerOk || !upperOk {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return numericKinds()
+}
+
+// Match returns true if actual is a number that is greater than or equal
+// to the lower bound and less than or equal to the upper bound
+func (m *between) Match(value interface{}) bool {
+	lower, lowerOk := numericValue(m.lower)
+	upper, upperOk := numericValue(m.upper)
+	actual, ok := numericValue(value)
+	if !lowerOk || !upperOk || !ok {
+		return false
+	}
+
+	l, lowerOk := actual.compare(lower)
+	u, upperOk := actual.compare(upper)
+	return lowerOk && upperOk && l >= 0 && u <= 0
+}
+
+// String returns a description of what the between matcher expects
+func (m *between) String() string {
+	return fmt.Sprintf("number between %v and %v", formatValue(m.lower), formatValue(m.upper))
+}
+
+// Explain returns an explanation of why the value does not match the between matcher
+func (m *between) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,62 @@
+package match
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("between", func() {
+	Describe("Between", func() {
+		It("returns a between struct", func() {
+			actual := Between(1, 10)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(between)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the numeric kinds", func() {
+			actual := Between(1, 10).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(numericKinds()))
+		})
+
+		DescribeTable("returns no kinds when a bound is not a number",
+			func(lower interface{}, upper interface{}) {
+				gomega.Expect(Between(lower, upper).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("lower", "1", 10),
+			Entry("upper", 1, nil),
+		)
+	})
+
+	DescribeTable("Match returns true",
+		func(lower interface{}, upper interface{}, actual interface{}) {
+			gomega.Expect(Between(lower, upper).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is the lower bound", 1, 10, 1),
+		Entry("when actual is the upper bound", 1, 10, uint8(10)),
+		Entry("when actual is between the bounds", -1.5, 1.5, float32(0)),
+		Entry("with a defined int type", 1, 10, namedInt(5)),
+		Entry("with a defined uint type", 1, 10, namedUint(5)),
+		Entry("with a defined float type", 1, 10, namedFloat(5)),
+		Entry("with a uint and a negative lower bound", -10, 10, uint(5)),
+	)
+
+	DescribeTable("Match returns false",
+		func(lower interface{}, upper interface{}, actual interface{}) {
+			gomega.Expect(Between(lower, upper).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1, 10, nil),
+		Entry("when actual is not a number", 1, 10, "5"),
+		Entry("when a bound is not a number", 1, "10", 5),
+		Entry("when actual is less than the lower bound", 1, 10, 0),
+		Entry("when actual is greater than the upper bound", 1, 10, 11),
+		Entry("when actual is a negative int and the lower bound is a uint", uint(0), 10, -1),
+		Entry("when the lower bound is greater than the upper bound", 10, 1, 5),
+		Entry("when actual is NaN", 1, 10, math.NaN()),
+	)
+})
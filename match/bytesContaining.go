@@ -0,0 +1,38 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// BytesContaining returns a new matcher that will match byte
+// slices and strings that contain the provided bytes
+func BytesContaining(expected []byte) SupportedKindsMatcher {
+	return &bytesContaining{expected}
+}
+
+type bytesContaining struct {
+	expected []byte
+}
+
+// SupportedKinds returns all the kinds the bytes containing matcher supports
+func (bytesContaining) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match returns true if actual contains the provided bytes; otherwise false
+func (m *bytesContaining) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	return ok && strings.Contains(s, string(m.expected))
+}
+
+// String returns a description of what the bytes containing matcher expects
+func (m *bytesContaining) String() string {
+	return fmt.Sprintf("bytes containing %q", m.expected)
+}
+
+// Explain returns an explanation of why the value does not match the bytes containing matcher
+func (m *bytesContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,43 @@
+package match
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("bytesContaining", func() {
+	Describe("BytesContaining", func() {
+		It("returns a bytesContaining struct", func() {
+			actual := BytesContaining([]byte("ock"))
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(bytesContaining)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the text kinds", func() {
+			gomega.Expect(BytesContaining([]byte("ock")).SupportedKinds()).To(gomega.Equal(textKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected []byte, actual interface{}) {
+			gomega.Expect(BytesContaining(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual contains the bytes", []byte("ock"), []byte("mocka")),
+		Entry("when actual is the bytes", []byte("mocka"), []byte("mocka")),
+		Entry("when actual is a string", []byte("ock"), "mocka"),
+		Entry("when the bytes are empty", []byte{}, []byte("mocka")),
+		Entry("when actual contains binary data", []byte{0x00, 0xff}, []byte{0x01, 0x00, 0xff, 0x02}),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected []byte, actual interface{}) {
+			gomega.Expect(BytesContaining(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", []byte("ock"), nil),
+		Entry("when actual is not text", []byte("ock"), 1),
+		Entry("when actual does not contain the bytes", []byte("ock"), []byte("gomega")),
+	)
+})
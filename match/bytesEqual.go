@@ -0,0 +1,37 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BytesEqual returns a new matcher that will match byte slices and strings
+// with the same content as the provided bytes; nil and empty are equal
+func BytesEqual(expected []byte) SupportedKindsMatcher {
+	return &bytesEqual{expected}
+}
+
+type bytesEqual struct {
+	expected []byte
+}
+
+// SupportedKinds returns all the kinds the bytes equal matcher supports
+func (bytesEqual) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match returns true if actual has the same content as the provided bytes; otherwise false
+func (m *bytesEqual) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	return ok && s == string(m.expected)
+}
+
+// String returns a description of what the bytes equal matcher expects
+func (m *bytesEqual) String() string {
+	return fmt.Sprintf("bytes equal to %q", m.expected)
+}
+
+// Explain returns an explanation of why the value does not match the bytes equal matcher
+func (m *bytesEqual) Explain(value interface{}) string {
+	return explain(m, value)
+}
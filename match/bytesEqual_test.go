@@ -0,0 +1,44 @@
+package match
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("bytesEqual", func() {
+	Describe("BytesEqual", func() {
+		It("returns a bytesEqual struct", func() {
+			actual := BytesEqual([]byte("mocka"))
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(bytesEqual)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the text kinds", func() {
+			gomega.Expect(BytesEqual([]byte("mocka")).SupportedKinds()).To(gomega.Equal(textKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected []byte, actual interface{}) {
+			gomega.Expect(BytesEqual(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the bytes are equal", []byte("mocka"), []byte("mocka")),
+		Entry("when actual is a string", []byte("mocka"), "mocka"),
+		Entry("when actual is a named string", []byte("mocka"), namedString("mocka")),
+		Entry("when both are empty", []byte{}, []byte{}),
+		Entry("when the expected bytes are nil and actual is empty", nil, []byte{}),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected []byte, actual interface{}) {
+			gomega.Expect(BytesEqual(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", []byte("mocka"), nil),
+		Entry("when actual is not text", []byte("mocka"), []int{1}),
+		Entry("when the bytes are different", []byte("mocka"), []byte("Mocka")),
+		Entry("when actual is a prefix", []byte("mocka"), []byte("mock")),
+	)
+})
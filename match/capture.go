@@ -0,0 +1,303 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/Bayer-Group/mocka/v2/internal/captures"
+)
+
+// captureIDs is used to give every capture matcher its own identity, so
+// custom arguments holding different capture matchers are never merged
+var captureIDs uint64
+
+func init() {
+	captures.Hold = func(m interface{}) { holdCaptures(m) }
+	captures.Settle = func(m interface{}, commit bool) { settleCaptures(m, commit) }
+}
+
+// capturingMatcher describes a matcher that can hold the values it matched
+// until they are committed or discarded
+type capturingMatcher interface {
+	hold()
+	settle(commit bool)
+}
+
+// holdCaptures makes the capture matchers within the matcher hold the values
+// they match until they are settled, which stubs do once they know the custom
+// arguments used for a call
+func holdCaptures(m interface{}) {
+	if t, ok := m.(capturingMatcher); ok {
+		t.hold()
+		return
+	}
+
+	for _, child := range childMatchers(m) {
+		holdCaptures(child)
+	}
+}
+
+// settleCaptures commits or discards the held values of the capture matchers
+// within the matcher
+func settleCaptures(m interface{}, commit bool) {
+	if t, ok := m.(capturingMatcher); ok {
+		t.settle(commit)
+		return
+	}
+
+	for _, child := range childMatchers(m) {
+		settleCaptures(child, commit)
+	}
+}
+
+// childMatchers returns the matchers evaluated by the matcher
+func childMatchers(m interface{}) []SupportedKindsMatcher {
+	switch t := m.(type) {
+	case *allOf:
+		return t.matchers
+	case *anyOf:
+		return t.matchers
+	case *sliceOf:
+		return t.matchers
+	case *not:
+		return []SupportedKindsMatcher{t.matcher}
+	case *pointsTo:
+		return []SupportedKindsMatcher{t.matcher}
+	case *fieldsMatcher:
+		matchers := make([]SupportedKindsMatcher, 0, len(t.fields))
+		for _, matcher := range t.fields {
+			matchers = append(matchers, matcher)
+		}
+
+		return matchers
+	case *mapOf:
+		matchers := make([]SupportedKindsMatcher, 0, len(t.entries))
+		for _, matcher := range t.entries {
+			matchers = append(matchers, matcher)
+		}
+
+		return matchers
+	case *consistsOf:
+		return matchersIn(t.elements...)
+	case *containsElementMatching:
+		return matchersIn(t.element)
+	case *every:
+		return matchersIn(t.element)
+	case *contextWithValue:
+		return matchersIn(t.value)
+	case *jsonPath:
+		return matchersIn(t.value)
+	case *readerContent:
+		return matchersIn(t.value)
+	case *mapContaining:
+		return entryMatchersIn(t.keysAndValues...)
+	case *valuesContaining:
+		return matchersIn(t.values...)
+	case *HTTPRequestMatcher:
+		values := []interface{}{t.body}
+		for _, header := range t.headers {
+			values = append(values, header.value)
+		}
+
+		for _, query := range t.queries {
+			values = append(values, query.value)
+		}
+
+		return matchersIn(values...)
+	default:
+		return nil
+	}
+}
+
+// matchersIn returns the values that are matchers
+func matchersIn(values ...interface{}) []SupportedKindsMatcher {
+	var matchers []SupportedKindsMatcher
+	for _, value := range values {
+		if m, ok := value.(SupportedKindsMatcher); ok {
+			matchers = append(matchers, m)
+		}
+	}
+
+	return matchers
+}
+
+// entryMatchersIn returns the matchers within the values, including the
+// values of maps that are matched as a subset
+func entryMatchersIn(values ...interface{}) []SupportedKindsMatcher {
+	var matchers []SupportedKindsMatcher
+	for _, value := range values {
+		if m, ok := value.(SupportedKindsMatcher); ok {
+			matchers = append(matchers, m)
+			continue
+		}
+
+		if v := reflect.ValueOf(value); v.Kind() == reflect.Map {
+			iter := v.MapRange()
+			for iter.Next() {
+				matchers = append(matchers, entryMatchersIn(iter.Value().Interface())...)
+			}
+		}
+	}
+
+	return matchers
+}
+
+// Capture returns a new matcher that will match any value that can be assigned
+// to the element type of the provided pointer and stores the last matched value
+// in it. When used with a stub, the value is only stored if the custom arguments
+// holding the matcher are used for the call.
+func Capture(dst interface{}) SupportedKindsMatcher {
+	return &capture{id: atomic.AddUint64(&captureIDs, 1), dst: dst}
+}
+
+type capture struct {
+	id      uint64
+	dst     interface{}
+	held    bool
+	matched []reflect.Value
+}
+
+// SupportedKinds returns the kinds that can be assigned to the element type of
+// the provided pointer; a non-pointer supports no kinds
+func (m *capture) SupportedKinds() map[reflect.Kind]struct{} {
+	dst, ok := captureDestination(m.dst, false)
+	if !ok {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return assignableKinds(dst.Type())
+}
+
+// Match stores the value, or holds it until it is settled, and returns true if
+// it can be assigned to the element type of the provided pointer; otherwise false
+func (m *capture) Match(value interface{}) bool {
+	dst, ok := captureDestination(m.dst, false)
+	if !ok {
+		return false
+	}
+
+	v, ok := assignableValue(value, dst.Type())
+	if !ok {
+		return false
+	}
+
+	if !m.held {
+		dst.Set(v)
+		return true
+	}
+
+	m.matched = []reflect.Value{v}
+	return true
+}
+
+// hold makes the matcher hold the matched values until they are settled
+func (m *capture) hold() {
+	m.held = true
+}
+
+// settle stores the last matched value in the provided pointer when committing
+func (m *capture) settle(commit bool) {
+	if dst, ok := captureDestination(m.dst, false); ok && commit && len(m.matched) > 0 {
+		dst.Set(m.matched[len(m.matched)-1])
+	}
+
+	m.matched = nil
+}
+
+// String returns a description of what the capture matcher expects
+func (m *capture) String() string {
+	return fmt.Sprintf("value captured into %v", describeType(m.dst))
+}
+
+// Explain returns an explanation of why the value does not match the capture matcher
+func (m *capture) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// CaptureAll returns a new matcher that will match any value that can be
+// assigned to the element type of the provided pointer to a slice and appends
+// every matched value to it. When used with a stub, the values are only appended
+// for calls that use the custom arguments holding the matcher.
+func CaptureAll(dst interface{}) SupportedKindsMatcher {
+	return &captureAll{id: atomic.AddUint64(&captureIDs, 1), dst: dst}
+}
+
+type captureAll struct {
+	id      uint64
+	dst     interface{}
+	held    bool
+	matched []reflect.Value
+}
+
+// SupportedKinds returns the kinds that can be assigned to the element type of
+// the provided slice; anything other than a pointer to a slice supports no kinds
+func (m *captureAll) SupportedKinds() map[reflect.Kind]struct{} {
+	dst, ok := captureDestination(m.dst, true)
+	if !ok {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return assignableKinds(dst.Type().Elem())
+}
+
+// Match appends the value, or holds it until it is settled, and returns true if
+// it can be assigned to the element type of the provided slice; otherwise false
+func (m *captureAll) Match(value interface{}) bool {
+	dst, ok := captureDestination(m.dst, true)
+	if !ok {
+		return false
+	}
+
+	v, ok := assignableValue(value, dst.Type().Elem())
+	if !ok {
+		return false
+	}
+
+	if !m.held {
+		dst.Set(reflect.Append(dst, v))
+		return true
+	}
+
+	m.matched = append(m.matched, v)
+	return true
+}
+
+// hold makes the matcher hold the matched values until they are settled
+func (m *captureAll) hold() {
+	m.held = true
+}
+
+// settle appends every matched value to the slice when committing
+func (m *captureAll) settle(commit bool) {
+	if dst, ok := captureDestination(m.dst, true); ok && commit {
+		dst.Set(reflect.Append(dst, m.matched...))
+	}
+
+	m.matched = nil
+}
+
+// String returns a description of what the capture all matcher expects
+func (m *captureAll) String() string {
+	return fmt.Sprintf("values captured into %v", describeType(m.dst))
+}
+
+// Explain returns an explanation of why the value does not match the capture all matcher
+func (m *captureAll) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// captureDestination returns the value the pointer points to and true if
+// the pointer is non-nil and, when required, points to a slice; otherwise false
+func captureDestination(ptr interface{}, slice bool) (reflect.Value, bool) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	if slice && v.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, false
+	}
+
+	return v.Elem(), true
+}
@@ -0,0 +1,268 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("capture", func() {
+	Describe("Capture", func() {
+		It("returns a capture struct", func() {
+			var dst string
+			actual := Capture(&dst)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(capture)))
+		})
+
+		It("returns a different matcher for every call", func() {
+			var dst string
+
+			gomega.Expect(reflect.DeepEqual(Capture(&dst), Capture(&dst))).To(gomega.BeFalse())
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kind of the element type and interface", func() {
+			var dst string
+			actual := Capture(&dst).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String:    {},
+					reflect.Interface: {},
+				}))
+		})
+
+		It("returns all kinds when the element type is an interface", func() {
+			var dst interface{}
+			actual := Capture(&dst).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(Anything().SupportedKinds()))
+		})
+
+		DescribeTable("returns no kinds when the destination is invalid",
+			func(dst interface{}) {
+				gomega.Expect(Capture(dst).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("nil", nil),
+			Entry("not a pointer", "string"),
+			Entry("nil pointer", (*string)(nil)),
+		)
+	})
+
+	Describe("Match", func() {
+		It("stores the last matched value", func() {
+			var dst func(int)
+			matcher := Capture(&dst)
+			callbacks := []int{}
+
+			gomega.Expect(matcher.Match(func(i int) { callbacks = append(callbacks, i) })).To(gomega.BeTrue())
+			gomega.Expect(matcher.Match(func(i int) { callbacks = append(callbacks, i*10) })).To(gomega.BeTrue())
+			dst(2)
+
+			gomega.Expect(callbacks).To(gomega.Equal([]int{20}))
+		})
+
+		It("holds the last matched value until it is committed", func() {
+			dst := "A"
+			matcher := Capture(&dst)
+			holdCaptures(matcher)
+
+			gomega.Expect(matcher.Match("B")).To(gomega.BeTrue())
+			gomega.Expect(matcher.Match("C")).To(gomega.BeTrue())
+			gomega.Expect(dst).To(gomega.Equal("A"))
+
+			settleCaptures(matcher, true)
+
+			gomega.Expect(dst).To(gomega.Equal("C"))
+		})
+
+		It("does not store the held value once discarded", func() {
+			dst := "A"
+			matcher := Capture(&dst)
+			holdCaptures(matcher)
+
+			gomega.Expect(matcher.Match("B")).To(gomega.BeTrue())
+			settleCaptures(matcher, false)
+			settleCaptures(matcher, true)
+
+			gomega.Expect(dst).To(gomega.Equal("A"))
+		})
+
+		It("stores nil when the element type can be nil", func() {
+			dst := []int{1}
+			matcher := Capture(&dst)
+
+			gomega.Expect(matcher.Match(nil)).To(gomega.BeTrue())
+
+			gomega.Expect(dst).To(gomega.BeNil())
+		})
+
+		DescribeTable("returns false and does not store the value",
+			func(value interface{}) {
+				dst := 5
+				matcher := Capture(&dst)
+
+				gomega.Expect(matcher.Match(value)).To(gomega.BeFalse())
+
+				gomega.Expect(dst).To(gomega.Equal(5))
+			},
+			Entry("when the value is not assignable", "A"),
+			Entry("when the value is nil", nil),
+		)
+
+		It("returns false when the destination is invalid", func() {
+			gomega.Expect(Capture("string").Match("A")).To(gomega.BeFalse())
+		})
+	})
+})
+
+var _ = Describe("captureAll", func() {
+	Describe("CaptureAll", func() {
+		It("returns a captureAll struct", func() {
+			var dst []string
+			actual := CaptureAll(&dst)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(captureAll)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kind of the slice element type and interface", func() {
+			var dst []int
+			actual := CaptureAll(&dst).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Int:       {},
+					reflect.Interface: {},
+				}))
+		})
+
+		DescribeTable("returns no kinds when the destination is invalid",
+			func(dst interface{}) {
+				gomega.Expect(CaptureAll(dst).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("nil", nil),
+			Entry("not a pointer", []int{}),
+			Entry("nil pointer", (*[]int)(nil)),
+			Entry("pointer to a non slice", new(int)),
+		)
+	})
+
+	Describe("Match", func() {
+		It("appends every matched value", func() {
+			var dst []string
+			matcher := CaptureAll(&dst)
+
+			gomega.Expect(matcher.Match("A")).To(gomega.BeTrue())
+			gomega.Expect(matcher.Match("B")).To(gomega.BeTrue())
+			gomega.Expect(matcher.Match(1)).To(gomega.BeFalse())
+
+			gomega.Expect(dst).To(gomega.Equal([]string{"A", "B"}))
+		})
+
+		It("holds the matched values until they are committed", func() {
+			var dst []string
+			matcher := CaptureAll(&dst)
+			holdCaptures(matcher)
+
+			gomega.Expect(matcher.Match("A")).To(gomega.BeTrue())
+			gomega.Expect(matcher.Match("B")).To(gomega.BeTrue())
+			gomega.Expect(dst).To(gomega.BeNil())
+
+			settleCaptures(matcher, true)
+			settleCaptures(matcher, true)
+
+			gomega.Expect(dst).To(gomega.Equal([]string{"A", "B"}))
+		})
+
+		It("does not append the held values once discarded", func() {
+			dst := []string{"A"}
+			matcher := CaptureAll(&dst)
+			holdCaptures(matcher)
+
+			gomega.Expect(matcher.Match("B")).To(gomega.BeTrue())
+			settleCaptures(matcher, false)
+			gomega.Expect(matcher.Match("C")).To(gomega.BeTrue())
+			settleCaptures(matcher, true)
+
+			gomega.Expect(dst).To(gomega.Equal([]string{"A", "C"}))
+		})
+
+		It("returns false when the destination is invalid", func() {
+			gomega.Expect(CaptureAll(new(int)).Match(1)).To(gomega.BeFalse())
+		})
+	})
+})
+
+var _ = Describe("settleCaptures", func() {
+	It("commits the held values of the matchers evaluated by other matchers", func() {
+		var first, second string
+		var all []int
+		matcher := AllOf(
+			Fields(map[string]SupportedKindsMatcher{"Name": Capture(&first)}),
+			PointsTo(Fields(map[string]SupportedKindsMatcher{"Name": Not(Not(Capture(&second)))})),
+		)
+		slice := Every(CaptureAll(&all))
+		holdCaptures(matcher)
+		holdCaptures(slice)
+
+		gomega.Expect(matcher.Match(&struct{ Name string }{"A"})).To(gomega.BeTrue())
+		gomega.Expect(slice.Match([]int{1, 2})).To(gomega.BeTrue())
+		gomega.Expect(first).To(gomega.BeEmpty())
+		gomega.Expect(all).To(gomega.BeNil())
+
+		settleCaptures(matcher, true)
+		settleCaptures(slice, true)
+
+		gomega.Expect(first).To(gomega.Equal("A"))
+		gomega.Expect(second).To(gomega.Equal("A"))
+		gomega.Expect(all).To(gomega.Equal([]int{1, 2}))
+	})
+
+	It("discards the held values of the matchers evaluated by other matchers", func() {
+		var dst string
+		matcher := MapContaining("key", map[string]interface{}{"nested": Capture(&dst)})
+		holdCaptures(matcher)
+
+		gomega.Expect(matcher.Match(map[string]interface{}{"key": map[string]interface{}{"nested": "A"}})).To(gomega.BeTrue())
+		settleCaptures(matcher, false)
+		settleCaptures(matcher, true)
+
+		gomega.Expect(dst).To(gomega.BeEmpty())
+	})
+
+	It("ignores matchers that do not capture", func() {
+		gomega.Expect(func() { holdCaptures(Exactly(1)) }).ToNot(gomega.Panic())
+		gomega.Expect(func() { settleCaptures(Exactly(1), true) }).ToNot(gomega.Panic())
+		gomega.Expect(func() { settleCaptures(nil, true) }).ToNot(gomega.Panic())
+		gomega.Expect(func() { settleCaptures(Not(nil), true) }).ToNot(gomega.Panic())
+	})
+})
+
+var _ = DescribeTable("childMatchers",
+	func(matcher SupportedKindsMatcher, expected int) {
+		gomega.Expect(childMatchers(matcher)).To(gomega.HaveLen(expected))
+	},
+	Entry("of AllOf", AllOf(Anything(), Anything()), 2),
+	Entry("of AnyOf", AnyOf(Anything()), 1),
+	Entry("of SliceOf", SliceOf(Anything(), Anything()), 2),
+	Entry("of Not", Not(Anything()), 1),
+	Entry("of PointsTo", PointsTo(Anything()), 1),
+	Entry("of Fields", Fields(map[string]SupportedKindsMatcher{"A": Anything(), "B": Anything()}), 2),
+	Entry("of MapOf", MapOf(map[interface{}]SupportedKindsMatcher{"A": Anything()}), 1),
+	Entry("of ConsistsOf", ConsistsOf(1, Anything()), 1),
+	Entry("of ContainsElementMatching", ContainsElementMatching(Anything()), 1),
+	Entry("of Every", Every(Anything()), 1),
+	Entry("of ContextWithValue", ContextWithValue("key", Anything()), 1),
+	Entry("of JSONPath", JSONPath("a", Anything()), 1),
+	Entry("of ReaderContent", ReaderContent(Anything()), 1),
+	Entry("of MapContaining", MapContaining("a", map[string]interface{}{"b": Anything()}), 1),
+	Entry("of ValuesContaining", ValuesContaining(Anything(), "a"), 1),
+	Entry("of HTTPRequest", HTTPRequest().Header("a", Anything()).Query("b", Anything()).Body(Anything()), 3),
+	Entry("of a matcher without children", Exactly(1), 0),
+)
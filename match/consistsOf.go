@@ -0,0 +1,100 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ConsistsOf returns a new matcher that will match slices and arrays whose
+// elements match the provided elements in any order. The elements can be
+// matchers or values that are compared using reflect.DeepEqual, and every
+// element must be matched by a different value. Channels are not supported,
+// since reading their buffered values would take them from the code under test.
+func ConsistsOf(elements ...interface{}) SupportedKindsMatcher {
+	return &consistsOf{elements}
+}
+
+type consistsOf struct {
+	elements []interface{}
+}
+
+// SupportedKinds returns all the kinds the consists of matcher supports
+func (consistsOf) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Slice: {},
+		reflect.Array: {},
+	}
+}
+
+// Match return true if each value in the slice or array can be paired
+// with a different element that it matches; otherwise false
+func (m *consistsOf) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() != len(m.elements) {
+			return false
+		}
+
+		matches := make([][]int, v.Len())
+		for i := range matches {
+			for j, e := range m.elements {
+				if matchesValue(e, v.Index(i)) {
+					matches[i] = append(matches[i], j)
+				}
+			}
+		}
+
+		return maximumMatching(matches, len(m.elements)) == v.Len()
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the consists of matcher expects
+func (m *consistsOf) String() string {
+	return fmt.Sprintf("elements consisting of (%v)", describeValues(m.elements))
+}
+
+// Explain returns an explanation of why the value does not match the consists of matcher
+func (m *consistsOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// maximumMatching returns the size of the maximum bipartite matching between
+// values and elements, where matches holds the elements each value matches
+func maximumMatching(matches [][]int, numElements int) int {
+	pairedWith := make([]int, numElements)
+	for j := range pairedWith {
+		pairedWith[j] = -1
+	}
+
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for _, j := range matches[i] {
+			if visited[j] {
+				continue
+			}
+
+			visited[j] = true
+			if pairedWith[j] == -1 || augment(pairedWith[j], visited) {
+				pairedWith[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	var size int
+	for i := range matches {
+		if augment(i, make([]bool, numElements)) {
+			size++
+		}
+	}
+
+	return size
+}
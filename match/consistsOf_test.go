@@ -0,0 +1,61 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("consistsOf", func() {
+	Describe("ConsistsOf", func() {
+		It("returns a consistsOf struct", func() {
+			actual := ConsistsOf()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(consistsOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ConsistsOf().SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Slice: {},
+					reflect.Array: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(elements []interface{}, actual interface{}) {
+			gomega.Expect(ConsistsOf(elements...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the slice is empty", []interface{}{}, []int{}),
+		Entry("when the values are in the same order", []interface{}{1, 2, 3}, []int{1, 2, 3}),
+		Entry("when the values are in a different order", []interface{}{3, 1, 2}, []int{1, 2, 3}),
+		Entry("when the values are in an array", []interface{}{"b", "a"}, [2]string{"a", "b"}),
+		Entry("when the values contain duplicates", []interface{}{1, 2, 1}, []int{1, 1, 2}),
+		Entry("when the values match the matchers", []interface{}{GreaterThan(2), LessThan(2)}, []int{1, 3}),
+		Entry("when a greedy pairing would fail", []interface{}{GreaterThan(0), Exactly(1)}, []int{1, 2}),
+		Entry("when the slice holds interfaces", []interface{}{StringPrefix("mo"), 2}, []interface{}{2, "mocka"}),
+		Entry("when the maps are equal", []interface{}{map[string]int{"b": 2}, map[string]int{"a": 1}}, []map[string]int{{"a": 1}, {"b": 2}}),
+	)
+
+	DescribeTable("Match returns false",
+		func(elements []interface{}, actual interface{}) {
+			gomega.Expect(ConsistsOf(elements...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", []interface{}{}, nil),
+		Entry("when actual is not a slice", []interface{}{}, "a"),
+		Entry("when the slice has more values", []interface{}{1, 2}, []int{1, 2, 3}),
+		Entry("when the slice has fewer values", []interface{}{1, 2, 3}, []int{1, 2}),
+		Entry("when a value does not match", []interface{}{1, 2, 4}, []int{1, 2, 3}),
+		Entry("when a value is duplicated", []interface{}{1, 2, 2}, []int{1, 1, 2}),
+		Entry("when two values can only match the same matcher", []interface{}{GreaterThan(1), Exactly(1)}, []int{1, 1}),
+		Entry("when a map is only a subset", []interface{}{map[string]int{"a": 1}}, []map[string]int{{"a": 1, "b": 2}}),
+		Entry("when actual is a channel", []interface{}{}, make(chan int)),
+	)
+})
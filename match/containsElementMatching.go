@@ -0,0 +1,56 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ContainsElementMatching returns a new matcher that will match slices and
+// arrays with at least one element matching the provided element, which can
+// be a matcher or a value that is compared using reflect.DeepEqual. Channels
+// are not supported, like for ConsistsOf.
+func ContainsElementMatching(element interface{}) SupportedKindsMatcher {
+	return &containsElementMatching{element}
+}
+
+type containsElementMatching struct {
+	element interface{}
+}
+
+// SupportedKinds returns all the kinds the contains element matching matcher supports
+func (containsElementMatching) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Slice: {},
+		reflect.Array: {},
+	}
+}
+
+// Match return true if any element in the slice or array matches; otherwise false
+func (m *containsElementMatching) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if matchesValue(m.element, v.Index(i)) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the contains element matching matcher expects
+func (m *containsElementMatching) String() string {
+	return fmt.Sprintf("elements containing an element matching %v", describeValue(m.element))
+}
+
+// Explain returns an explanation of why the value does not match the contains element matching matcher
+func (m *containsElementMatching) Explain(value interface{}) string {
+	return explain(m, value)
+}
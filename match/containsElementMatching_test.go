@@ -0,0 +1,55 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("containsElementMatching", func() {
+	Describe("ContainsElementMatching", func() {
+		It("returns a containsElementMatching struct", func() {
+			actual := ContainsElementMatching(1)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(containsElementMatching)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ContainsElementMatching(1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Slice: {},
+					reflect.Array: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(element interface{}, actual interface{}) {
+			gomega.Expect(ContainsElementMatching(element).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the slice contains the value", 2, []int{1, 2, 3}),
+		Entry("when the array contains the value", "b", [2]string{"a", "b"}),
+		Entry("when a value matches the matcher", StringSuffix("ka"), []string{"gomock", "mocka"}),
+		Entry("when a map is equal", map[string]int{"a": 1}, []map[string]int{{"b": 2}, {"a": 1}}),
+		Entry("when a struct matches the fields", Fields(map[string]SupportedKindsMatcher{"City": Exactly("Chicago")}), []fieldsAddress{{City: "Denver"}, {City: "Chicago"}}),
+	)
+
+	DescribeTable("Match returns false",
+		func(element interface{}, actual interface{}) {
+			gomega.Expect(ContainsElementMatching(element).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1, nil),
+		Entry("when actual is not a slice", 1, 1),
+		Entry("when the slice is empty", 1, []int{}),
+		Entry("when the slice does not contain the value", 4, []int{1, 2, 3}),
+		Entry("when no value matches the matcher", GreaterThan(3), []int{1, 2, 3}),
+		Entry("when a map is only a subset", map[string]int{"a": 1}, []map[string]int{{"a": 1, "b": 2}}),
+		Entry("when actual is a channel", 1, make(chan int, 1)),
+	)
+})
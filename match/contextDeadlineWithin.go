@@ -0,0 +1,44 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ContextDeadlineWithin returns a new matcher that will match a context.Context
+// whose deadline is no later than the provided duration from when it is matched
+func ContextDeadlineWithin(d time.Duration) SupportedKindsMatcher {
+	return &contextDeadlineWithin{d}
+}
+
+type contextDeadlineWithin struct {
+	duration time.Duration
+}
+
+// SupportedKinds returns all the kinds the context deadline within matcher supports
+func (contextDeadlineWithin) SupportedKinds() map[reflect.Kind]struct{} {
+	return contextKinds()
+}
+
+// Match returns true if the context has a deadline within the provided
+// duration; otherwise false. Deadlines that have passed are within the duration.
+func (m *contextDeadlineWithin) Match(value interface{}) bool {
+	ctx, ok := contextValue(value)
+	if !ok {
+		return false
+	}
+
+	deadline, ok := ctx.Deadline()
+	return ok && time.Until(deadline) <= m.duration
+}
+
+// String returns a description of what the context deadline within matcher expects
+func (m *contextDeadlineWithin) String() string {
+	return fmt.Sprintf("context with a deadline within %v", m.duration)
+}
+
+// Explain returns an explanation of why the value does not match the context deadline within matcher
+func (m *contextDeadlineWithin) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,63 @@
+package match
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("contextDeadlineWithin", func() {
+	Describe("ContextDeadlineWithin", func() {
+		It("returns a contextDeadlineWithin struct", func() {
+			actual := ContextDeadlineWithin(time.Second)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(contextDeadlineWithin)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ContextDeadlineWithin(time.Second).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	Describe("Match", func() {
+		It("returns true when the deadline is within the duration", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			gomega.Expect(ContextDeadlineWithin(5 * time.Second).Match(ctx)).To(gomega.BeTrue())
+		})
+
+		It("returns true when the deadline has passed", func() {
+			ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+
+			gomega.Expect(ContextDeadlineWithin(time.Second).Match(ctx)).To(gomega.BeTrue())
+		})
+
+		It("returns false when the deadline is after the duration", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			gomega.Expect(ContextDeadlineWithin(5 * time.Second).Match(ctx)).To(gomega.BeFalse())
+		})
+
+		It("returns false when the context does not have a deadline", func() {
+			gomega.Expect(ContextDeadlineWithin(time.Minute).Match(context.Background())).To(gomega.BeFalse())
+		})
+
+		It("returns false when actual is not a context", func() {
+			gomega.Expect(ContextDeadlineWithin(time.Minute).Match(time.Minute)).To(gomega.BeFalse())
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package match
+
+import (
+	"reflect"
+)
+
+// ContextDone returns a new matcher that will match a context.Context
+// that has been canceled or whose deadline has passed
+func ContextDone() SupportedKindsMatcher {
+	return &contextDone{}
+}
+
+type contextDone struct {
+}
+
+// SupportedKinds returns all the kinds the context done matcher supports
+func (contextDone) SupportedKinds() map[reflect.Kind]struct{} {
+	return contextKinds()
+}
+
+// Match returns true if the context is done; otherwise false
+func (contextDone) Match(value interface{}) bool {
+	ctx, ok := contextValue(value)
+	return ok && ctx.Err() != nil
+}
+
+// String returns a description of what the context done matcher expects
+func (contextDone) String() string {
+	return "context that is done"
+}
+
+// Explain returns an explanation of why the value does not match the context done matcher
+func (m contextDone) Explain(value interface{}) string {
+	return explain(m, value)
+}
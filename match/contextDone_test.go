@@ -0,0 +1,54 @@
+package match
+
+import (
+	"context"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("contextDone", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("ContextDone", func() {
+		It("returns a contextDone struct", func() {
+			actual := ContextDone()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(contextDone)))
+		})
+
+		It("supports interfaces and pointers", func() {
+			gomega.Expect(ContextDone().SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+
+		It("matches a canceled context", func() {
+			cancel()
+
+			gomega.Expect(ContextDone().Match(ctx)).To(gomega.BeTrue())
+		})
+
+		It("does not match a context that is not done", func() {
+			gomega.Expect(ContextDone().Match(ctx)).To(gomega.BeFalse())
+		})
+
+		It("does not match a value that is not a context", func() {
+			gomega.Expect(ContextDone().Match(nil)).To(gomega.BeFalse())
+		})
+	})
+})
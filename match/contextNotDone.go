@@ -0,0 +1,35 @@
+package match
+
+import (
+	"reflect"
+)
+
+// ContextNotDone returns a new matcher that will match a context.Context
+// that has not been canceled and whose deadline has not passed
+func ContextNotDone() SupportedKindsMatcher {
+	return &contextNotDone{}
+}
+
+type contextNotDone struct {
+}
+
+// SupportedKinds returns all the kinds the context not done matcher supports
+func (contextNotDone) SupportedKinds() map[reflect.Kind]struct{} {
+	return contextKinds()
+}
+
+// Match returns true if the context is not done; otherwise false
+func (contextNotDone) Match(value interface{}) bool {
+	ctx, ok := contextValue(value)
+	return ok && ctx.Err() == nil
+}
+
+// String returns a description of what the context not done matcher expects
+func (contextNotDone) String() string {
+	return "context that is not done"
+}
+
+// Explain returns an explanation of why the value does not match the context not done matcher
+func (m contextNotDone) Explain(value interface{}) string {
+	return explain(m, value)
+}
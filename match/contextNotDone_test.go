@@ -0,0 +1,54 @@
+package match
+
+import (
+	"context"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("contextNotDone", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("ContextNotDone", func() {
+		It("returns a contextNotDone struct", func() {
+			actual := ContextNotDone()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(contextNotDone)))
+		})
+
+		It("supports interfaces and pointers", func() {
+			gomega.Expect(ContextNotDone().SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+
+		It("matches a context that is not done", func() {
+			gomega.Expect(ContextNotDone().Match(ctx)).To(gomega.BeTrue())
+		})
+
+		It("does not match a canceled context", func() {
+			cancel()
+
+			gomega.Expect(ContextNotDone().Match(ctx)).To(gomega.BeFalse())
+		})
+
+		It("does not match a value that is not a context", func() {
+			gomega.Expect(ContextNotDone().Match("context")).To(gomega.BeFalse())
+		})
+	})
+})
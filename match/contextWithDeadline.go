@@ -0,0 +1,40 @@
+package match
+
+import (
+	"reflect"
+)
+
+// ContextWithDeadline returns a new matcher that will match a
+// context.Context that has a deadline
+func ContextWithDeadline() SupportedKindsMatcher {
+	return &contextWithDeadline{}
+}
+
+type contextWithDeadline struct {
+}
+
+// SupportedKinds returns all the kinds the context with deadline matcher supports
+func (contextWithDeadline) SupportedKinds() map[reflect.Kind]struct{} {
+	return contextKinds()
+}
+
+// Match returns true if the context has a deadline; otherwise false
+func (contextWithDeadline) Match(value interface{}) bool {
+	ctx, ok := contextValue(value)
+	if !ok {
+		return false
+	}
+
+	_, ok = ctx.Deadline()
+	return ok
+}
+
+// String returns a description of what the context with deadline matcher expects
+func (contextWithDeadline) String() string {
+	return "context with a deadline"
+}
+
+// Explain returns an explanation of why the value does not match the context with deadline matcher
+func (m contextWithDeadline) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,56 @@
+package match
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("contextWithDeadline", func() {
+	Describe("ContextWithDeadline", func() {
+		It("returns a contextWithDeadline struct", func() {
+			actual := ContextWithDeadline()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(contextWithDeadline)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ContextWithDeadline().SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	Describe("Match", func() {
+		It("returns true when the context has a deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			gomega.Expect(ContextWithDeadline().Match(ctx)).To(gomega.BeTrue())
+		})
+
+		It("returns false when the context does not have a deadline", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			gomega.Expect(ContextWithDeadline().Match(ctx)).To(gomega.BeFalse())
+		})
+
+		It("returns false when actual is not a context", func() {
+			gomega.Expect(ContextWithDeadline().Match(time.Now())).To(gomega.BeFalse())
+		})
+
+		It("returns false when actual is nil", func() {
+			gomega.Expect(ContextWithDeadline().Match(nil)).To(gomega.BeFalse())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ContextWithValue returns a new matcher that will match a context.Context
+// when the value stored for the provided key matches the provided value,
+// which can be a matcher or a value that is compared using reflect.DeepEqual
+func ContextWithValue(key interface{}, value interface{}) SupportedKindsMatcher {
+	return &contextWithValue{key, value}
+}
+
+type contextWithValue struct {
+	key   interface{}
+	value interface{}
+}
+
+// SupportedKinds returns all the kinds the context with value matcher supports
+func (contextWithValue) SupportedKinds() map[reflect.Kind]struct{} {
+	return contextKinds()
+}
+
+// Match returns true if the value stored in the context for the key matches; otherwise false
+func (m *contextWithValue) Match(value interface{}) bool {
+	ctx, ok := contextValue(value)
+	if !ok {
+		return false
+	}
+
+	actual := ctx.Value(m.key)
+	return matchesValue(m.value, reflect.ValueOf(&actual).Elem())
+}
+
+// String returns a description of what the context with value matcher expects
+func (m *contextWithValue) String() string {
+	return fmt.Sprintf("context with value for key %v matching %v", formatValue(m.key), describeValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the context with value matcher
+func (m *contextWithValue) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,56 @@
+package match
+
+import (
+	"context"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+type contextKey string
+
+var _ = Describe("contextWithValue", func() {
+	Describe("ContextWithValue", func() {
+		It("returns a contextWithValue struct", func() {
+			actual := ContextWithValue(contextKey("trace"), "abc")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(contextWithValue)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ContextWithValue(contextKey("trace"), "abc").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(key interface{}, value interface{}, actual interface{}) {
+			gomega.Expect(ContextWithValue(key, value).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the context has the value", contextKey("trace"), "abc", context.WithValue(context.Background(), contextKey("trace"), "abc")),
+		Entry("when a parent context has the value", contextKey("trace"), "abc", context.WithValue(context.WithValue(context.Background(), contextKey("trace"), "abc"), contextKey("user"), 1)),
+		Entry("when the value matches the matcher", contextKey("trace"), StringPrefix("ab"), context.WithValue(context.Background(), contextKey("trace"), "abc")),
+		Entry("when the context does not have the key and the value is nil", contextKey("trace"), nil, context.Background()),
+	)
+
+	DescribeTable("Match returns false",
+		func(key interface{}, value interface{}, actual interface{}) {
+			gomega.Expect(ContextWithValue(key, value).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", contextKey("trace"), "abc", nil),
+		Entry("when actual is not a context", contextKey("trace"), "abc", "abc"),
+		Entry("when the context does not have the key", contextKey("trace"), "abc", context.Background()),
+		Entry("when the key has a different type", "trace", "abc", context.WithValue(context.Background(), contextKey("trace"), "abc")),
+		Entry("when the value is different", contextKey("trace"), "xyz", context.WithValue(context.Background(), contextKey("trace"), "abc")),
+		Entry("when the value does not match the matcher", contextKey("trace"), GreaterThan(1), context.WithValue(context.Background(), contextKey("trace"), "abc")),
+	)
+})
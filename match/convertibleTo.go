@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -61,3 +62,13 @@ func (m *convertibleTo) Match(value interface{}) bool {
 
 	return actualType.ConvertibleTo(expectedType.Elem())
 }
+
+// String returns a description of what the convertible to matcher expects
+func (m *convertibleTo) String() string {
+	return fmt.Sprintf("value convertible to %v", describeType(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the convertible to matcher
+func (m *convertibleTo) Explain(value interface{}) string {
+	return explain(m, value)
+}
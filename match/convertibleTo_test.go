@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("convertibleTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("convertibleTo", func() {
 		It("returns a convertibleTo struct", func() {
 			actual := ConvertibleTo(new(int))
 
-			Expect(actual).To(BeAssignableToTypeOf(new(convertibleTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(convertibleTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("convertibleTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := ConvertibleTo(new(int)).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Bool:          {},
 					reflect.Int:           {},
@@ -55,7 +55,7 @@ var _ = Describe("convertibleTo", func() {
 
 	DescribeTable("Match returns true",
 		func(i interface{}, value interface{}) {
-			Expect(ConvertibleTo(i).Match(value)).To(BeTrue())
+			gomega.Expect(ConvertibleTo(i).Match(value)).To(gomega.BeTrue())
 		},
 		Entry("with numbers", new(int), int8(5)),
 		Entry("with strings", new([]int32), "hello"),
@@ -65,7 +65,7 @@ var _ = Describe("convertibleTo", func() {
 
 	DescribeTable("Match returns false",
 		func(i interface{}, value interface{}) {
-			Expect(ConvertibleTo(i).Match(value)).To(BeFalse())
+			gomega.Expect(ConvertibleTo(i).Match(value)).To(gomega.BeFalse())
 		},
 		Entry("when expected is nil", nil, &convertibleTo{}),
 		Entry("when actual is nil", new(SupportedKindsMatcher), nil),
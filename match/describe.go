@@ -0,0 +1,162 @@
+package match
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Describer describes what a matcher expects in a human readable way,
+// such as `string with prefix "/api"`. Every built in matcher is a Describer.
+type Describer interface {
+	// String returns the description of what the matcher expects
+	String() string
+}
+
+// Explainer explains why a value does not match a matcher in a human readable way,
+// such as `expected string with prefix "/api", got "/v2/x"`. Every built in matcher
+// is an Explainer.
+type Explainer interface {
+	// Explain returns an explanation of why the value does not match
+	Explain(interface{}) string
+}
+
+// Description returns the description of the matcher if it is a Describer;
+// otherwise the name of its type
+func Description(m SupportedKindsMatcher) string {
+	if d, ok := m.(Describer); ok {
+		return d.String()
+	}
+
+	return fmt.Sprintf("%T", m)
+}
+
+// Explanation returns the explanation of why the value does not match the matcher
+// if it is an Explainer; otherwise an explanation built from its description
+func Explanation(m SupportedKindsMatcher, value interface{}) string {
+	if e, ok := m.(Explainer); ok {
+		return e.Explain(value)
+	}
+
+	return explain(m, value)
+}
+
+// explain returns an explanation of the expected description and the actual value
+func explain(m SupportedKindsMatcher, value interface{}) string {
+	return fmt.Sprintf("expected %v, got %v", Description(m), formatValue(value))
+}
+
+// explainValue returns the explanation of why the actual value does not match
+// the expected value, which can be a matcher or a value like for matchesValue
+func explainValue(expected interface{}, actual reflect.Value) string {
+	if actual.Kind() == reflect.Interface && !actual.IsNil() {
+		actual = actual.Elem()
+	}
+
+	var value interface{}
+	if actual.IsValid() && actual.CanInterface() {
+		value = actual.Interface()
+	}
+
+	if matcher, ok := expected.(SupportedKindsMatcher); ok {
+		if _, ok := matcher.SupportedKinds()[actual.Kind()]; !ok && actual.IsValid() {
+			return fmt.Sprintf("expected %v, got %v which is not supported", Description(matcher), formatValue(value))
+		}
+
+		return Explanation(matcher, value)
+	}
+
+	return fmt.Sprintf("expected %v, got %v", formatValue(expected), formatValue(value))
+}
+
+// describeValue returns the description of the value if it is a matcher; otherwise the formatted value
+func describeValue(value interface{}) string {
+	if matcher, ok := value.(SupportedKindsMatcher); ok {
+		return Description(matcher)
+	}
+
+	return formatValue(value)
+}
+
+// describeValues returns the descriptions of the values separated by commas
+func describeValues(values []interface{}) string {
+	descriptions := make([]string, len(values))
+	for i, v := range values {
+		descriptions[i] = describeValue(v)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// describeMatchers returns the descriptions of the matchers separated by commas
+func describeMatchers(matchers []SupportedKindsMatcher) string {
+	descriptions := make([]string, len(matchers))
+	for i, m := range matchers {
+		descriptions[i] = describeValue(m)
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// describeEntries returns the formatted keys and the descriptions of their values
+// as "key: value" separated by commas, sorted by the formatted keys
+func describeEntries(keys []interface{}, values []interface{}) string {
+	entries := make([]string, len(keys))
+	for i := range keys {
+		entries[i] = fmt.Sprintf("%v: %v", formatValue(keys[i]), describeValue(values[i]))
+	}
+
+	sort.Strings(entries)
+	return strings.Join(entries, ", ")
+}
+
+// describeType returns the name of the type the provided pointer points to,
+// such as "error" for (*error)(nil); otherwise the name of the value's type
+func describeType(ptr interface{}) string {
+	t := reflect.TypeOf(ptr)
+	if t == nil {
+		return "<nil>"
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return t.Elem().String()
+	}
+
+	return t.String()
+}
+
+// formatValue returns the value formatted for descriptions and explanations
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case SupportedKindsMatcher:
+		return Description(v)
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []byte:
+		return fmt.Sprintf("[]byte(%q)", v)
+	case error:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return fmt.Sprintf("(%T)(nil)", v)
+		}
+
+		return fmt.Sprintf("%T(%q)", v, v.Error())
+	case *http.Request:
+		if v == nil || v.URL == nil {
+			return fmt.Sprintf("%#v", v)
+		}
+
+		return fmt.Sprintf("&http.Request{Method: %q, URL: %q}", requestMethod(v), v.URL.String())
+	case fmt.Stringer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return fmt.Sprintf("(%T)(nil)", v)
+		}
+
+		return v.String()
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
@@ -0,0 +1,247 @@
+package match
+
+import (
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("describe", func() {
+	It("implements Describer and Explainer for every built in matcher", func() {
+		types := []reflect.Type{
+			reflect.TypeOf(new(allOf)),
+			reflect.TypeOf(new(anyOf)),
+			reflect.TypeOf(new(pointsTo)),
+			reflect.TypeOf(new(satisfies)),
+			reflect.TypeOf(new(sliceOf)),
+		}
+		for t := range priorities {
+			types = append(types, t)
+		}
+
+		describer := reflect.TypeOf((*Describer)(nil)).Elem()
+		explainer := reflect.TypeOf((*Explainer)(nil)).Elem()
+		for _, t := range types {
+			gomega.Expect(t.Implements(describer)).To(gomega.BeTrue(), "%v is not a Describer", t)
+			gomega.Expect(t.Implements(explainer)).To(gomega.BeTrue(), "%v is not an Explainer", t)
+		}
+	})
+
+	Describe("Description", func() {
+		It("returns the description of a Describer", func() {
+			gomega.Expect(Description(StringPrefix("/api"))).To(gomega.Equal(`string with prefix "/api"`))
+		})
+
+		It("returns the type name of other matchers", func() {
+			gomega.Expect(Description(new(mockMatcher))).To(gomega.Equal("*match.mockMatcher"))
+		})
+	})
+
+	Describe("Explanation", func() {
+		It("returns the explanation of an Explainer", func() {
+			gomega.Expect(Explanation(StringPrefix("/api"), "/v2/x")).To(gomega.Equal(`expected string with prefix "/api", got "/v2/x"`))
+		})
+
+		It("returns an explanation using the type name of other matchers", func() {
+			gomega.Expect(Explanation(new(mockMatcher), 1)).To(gomega.Equal("expected *match.mockMatcher, got 1"))
+		})
+	})
+
+	DescribeTable("String returns a description of the matcher",
+		func(matcher SupportedKindsMatcher, expected string) {
+			gomega.Expect(Description(matcher)).To(gomega.Equal(expected))
+		},
+		Entry("for AllOf", AllOf(StringPrefix("a"), Not(Empty())), `all of (string with prefix "a", not empty value)`),
+		Entry("for AnyOf", AnyOf(Nil(), Exactly(1)), "any of (nil, exactly 1)"),
+		Entry("for Anything", Anything(), "anything"),
+		Entry("for AnythingButNil", AnythingButNil(), "anything but nil"),
+		Entry("for Between", Between(1, 2.5), "number between 1 and 2.5"),
+		Entry("for BytesContaining", BytesContaining([]byte("a")), `bytes containing "a"`),
+		Entry("for BytesEqual", BytesEqual([]byte("a")), `bytes equal to "a"`),
+		Entry("for Capture", Capture(new(string)), "value captured into string"),
+		Entry("for CaptureAll", CaptureAll(new([]string)), "values captured into []string"),
+		Entry("for ConsistsOf", ConsistsOf(1, GreaterThan(1)), "elements consisting of (1, number greater than 1)"),
+		Entry("for ContainsElementMatching", ContainsElementMatching(StringSuffix("z")), `elements containing an element matching string with suffix "z"`),
+		Entry("for ContextDeadlineWithin", ContextDeadlineWithin(time.Second), "context with a deadline within 1s"),
+		Entry("for ContextDone", ContextDone(), "context that is done"),
+		Entry("for ContextNotDone", ContextNotDone(), "context that is not done"),
+		Entry("for ContextWithDeadline", ContextWithDeadline(), "context with a deadline"),
+		Entry("for ContextWithValue", ContextWithValue(contextKey("id"), "a"), `context with value for key "id" matching "a"`),
+		Entry("for ConvertibleTo", ConvertibleTo((*int)(nil)), "value convertible to int"),
+		Entry("for DurationBetween", DurationBetween(time.Second, time.Minute), "duration between 1s and 1m0s"),
+		Entry("for ElementsContaining", ElementsContaining("a", "b"), `elements containing ("a", "b")`),
+		Entry("for Empty", Empty(), "empty value"),
+		Entry("for Equal", Equal([]int{1}), "value equal to []int{1}"),
+		Entry("for ErrorAs", ErrorAs(new(*typedError)), "error as *match.typedError"),
+		Entry("for ErrorContaining", ErrorContaining("timeout"), `error containing "timeout"`),
+		Entry("for ErrorIs", ErrorIs(io.EOF), `error is *errors.errorString("EOF")`),
+		Entry("for Every", Every(GreaterThan(0)), "every element matching number greater than 0"),
+		Entry("for Exactly", Exactly("a"), `exactly "a"`),
+		Entry("for Fields", Fields(map[string]SupportedKindsMatcher{"Name": Exactly("a"), "Address.City": Empty()}), `struct with fields {"Address.City": empty value, "Name": exactly "a"}`),
+		Entry("for Finite", Finite(), "finite number"),
+		Entry("for FloatGreaterThan", FloatGreaterThan(1.5), "float greater than 1.5"),
+		Entry("for FloatGreaterThanOrEqualTo", FloatGreaterThanOrEqualTo(1.5), "float greater than or equal to 1.5"),
+		Entry("for FloatLessThan", FloatLessThan(1.5), "float less than 1.5"),
+		Entry("for FloatLessThanOrEqualTo", FloatLessThanOrEqualTo(1.5), "float less than or equal to 1.5"),
+		Entry("for GreaterThan", GreaterThan(1), "number greater than 1"),
+		Entry("for HTTPRequest", HTTPRequest(), "*http.Request"),
+		Entry("for a narrowed HTTPRequest", HTTPRequest().Method("POST").Path("/v1/*").Header("Accept", "a").Query("page", "2").Body(Empty()),
+			`*http.Request with method "POST", path matching "/v1/*", header "Accept" matching "a", query "page" matching "2", body matching empty value`),
+		Entry("for ImplementerOf", ImplementerOf((*error)(nil)), "implementer of error"),
+		Entry("for InDelta", InDelta(0.3, 0.01), "number within 0.01 of 0.3"),
+		Entry("for IntGreaterThan", IntGreaterThan(1), "int greater than 1"),
+		Entry("for IntGreaterThanOrEqualTo", IntGreaterThanOrEqualTo(1), "int greater than or equal to 1"),
+		Entry("for IntLessThan", IntLessThan(1), "int less than 1"),
+		Entry("for IntLessThanOrEqualTo", IntLessThanOrEqualTo(1), "int less than or equal to 1"),
+		Entry("for JSONEq", JSONEq(`{ "b": 2, "a": 1 }`), `JSON equal to {"a":1,"b":2}`),
+		Entry("for JSONEq with invalid JSON", JSONEq(`{`), "JSON equal to invalid JSON"),
+		Entry("for JSONPath", JSONPath("items.0.id", 7), `JSON with "items.0.id" matching 7`),
+		Entry("for KeysContaining", KeysContaining("a"), `map with keys containing ("a")`),
+		Entry("for LengthOf", LengthOf(2), "value with length 2"),
+		Entry("for LessThan", LessThan(1), "number less than 1"),
+		Entry("for MapContaining", MapContaining("b", 2, "a", Empty()), `map containing {"a": empty value, "b": 2}`),
+		Entry("for MapContaining with a missing value", MapContaining("a", 1, "b"), `map containing {"a": 1} with a missing value for key "b"`),
+		Entry("for MapOf", MapOf(map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}), `map of {"a": exactly 1}`),
+		Entry("for NaN", NaN(), "NaN"),
+		Entry("for Nil", Nil(), "nil"),
+		Entry("for Not", Not(Nil()), "not nil"),
+		Entry("for OneOf", OneOf("a", nil), `one of ("a", nil)`),
+		Entry("for PointsTo", PointsTo(Exactly(1)), "pointer to exactly 1"),
+		Entry("for ReaderContent", ReaderContent("a"), `reader with content matching "a"`),
+		Entry("for Same with an unsupported value", Same(1), "same as 1"),
+		Entry("for Satisfies", Satisfies(func(string) bool { return true }), "value satisfying func(string) bool"),
+		Entry("for SliceOf", SliceOf(Exactly(1), Nil()), "elements (exactly 1, nil)"),
+		Entry("for StringContaining", StringContaining("a"), `string containing "a"`),
+		Entry("for StringEqualFold", StringEqualFold("a"), `string equal to "a" ignoring case`),
+		Entry("for StringEqualIgnoringWhitespace", StringEqualIgnoringWhitespace("a b"), `string equal to "a b" ignoring whitespace`),
+		Entry("for StringLengthOf", StringLengthOf(2), "string with length 2"),
+		Entry("for StringMatching", StringMatching(`^a+$`), `string matching "^a+$"`),
+		Entry("for StringMatching with an invalid expression", StringMatching("("), `string matching invalid regular expression "("`),
+		Entry("for StringPrefix", StringPrefix("a"), `string with prefix "a"`),
+		Entry("for StringSuffix", StringSuffix("a"), `string with suffix "a"`),
+		Entry("for TimeAfter", TimeAfter(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)), "time after 2021-06-01 12:00:00 +0000 UTC"),
+		Entry("for TimeBefore", TimeBefore(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)), "time before 2021-06-01 12:00:00 +0000 UTC"),
+		Entry("for TimeWithin", TimeWithin(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC), time.Second), "time within 1s of 2021-06-01 12:00:00 +0000 UTC"),
+		Entry("for TimeZone", TimeZone(time.UTC), `time in location "UTC"`),
+		Entry("for TimeZone with a nil location", TimeZone(nil), "time in location <nil>"),
+		Entry("for TypeOf", TypeOf("string"), "value of type string"),
+		Entry("for UintGreaterThan", UintGreaterThan(1), "uint greater than 1"),
+		Entry("for UintGreaterThanOrEqualTo", UintGreaterThanOrEqualTo(1), "uint greater than or equal to 1"),
+		Entry("for UintLessThan", UintLessThan(1), "uint less than 1"),
+		Entry("for UintLessThanOrEqualTo", UintLessThanOrEqualTo(1), "uint less than or equal to 1"),
+		Entry("for ValuesContaining", ValuesContaining(1), "map with values containing (1)"),
+	)
+
+	It("describes Same with the type and address of the value", func() {
+		value := 1
+
+		gomega.Expect(Description(Same(&value))).To(gomega.MatchRegexp(`^same \*int as 0x[0-9a-f]+$`))
+	})
+
+	DescribeTable("Explain returns an explanation of why the value does not match",
+		func(matcher SupportedKindsMatcher, value func() interface{}, expected string) {
+			gomega.Expect(Explanation(matcher, value())).To(gomega.Equal(expected))
+		},
+		Entry("for a simple matcher", StringPrefix("/api"), func() interface{} { return "/v2/x" },
+			`expected string with prefix "/api", got "/v2/x"`),
+		Entry("for a nil value", StringPrefix("/api"), func() interface{} { return nil },
+			`expected string with prefix "/api", got nil`),
+		Entry("for a byte slice", BytesEqual([]byte("a")), func() interface{} { return []byte("b") },
+			`expected bytes equal to "a", got []byte("b")`),
+		Entry("for an error", ErrorContaining("timeout"), func() interface{} { return errors.New("refused") },
+			`expected error containing "timeout", got *errors.errorString("refused")`),
+		Entry("for AllOf with the first matcher that does not match", AllOf(StringPrefix("a"), StringSuffix("z")), func() interface{} { return "abc" },
+			`expected string with suffix "z", got "abc"`),
+		Entry("for Every with the first element that does not match", Every(GreaterThan(0)), func() interface{} { return []int{1, -1} },
+			"element 1: expected number greater than 0, got -1"),
+		Entry("for Every with an unsupported element", Every(StringPrefix("a")), func() interface{} { return []interface{}{"a", 1} },
+			`element 1: expected string with prefix "a", got 1 which is not supported`),
+		Entry("for SliceOf with a different length", SliceOf(Exactly(1)), func() interface{} { return []int{1, 2} },
+			"expected 1 elements, got 2"),
+		Entry("for SliceOf with the first element that does not match", SliceOf(Exactly(1), Exactly(2)), func() interface{} { return []int{1, 3} },
+			"element 1: expected exactly 2, got 3"),
+		Entry("for Fields with the first field that does not match", Fields(map[string]SupportedKindsMatcher{"City": Exactly("Berlin")}), func() interface{} {
+			return fieldsAddress{City: "Paris"}
+		}, `field "City": expected exactly "Berlin", got "Paris"`),
+		Entry("for Fields with a missing field", Fields(map[string]SupportedKindsMatcher{"Street": Anything()}), func() interface{} {
+			return fieldsAddress{}
+		}, `expected struct with fields {"Street": anything}, but match.fieldsAddress has no exported field "Street"`),
+		Entry("for LengthOf with a length", LengthOf(2), func() interface{} { return []int{1} },
+			"expected value with length 2, got []int{1} with length 1"),
+		Entry("for MapOf with a different length", MapOf(map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}), func() interface{} {
+			return map[string]int{}
+		}, `expected map of {"a": exactly 1}, got map[string]int{} with 0 entries`),
+		Entry("for MapOf with a missing key", MapOf(map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}), func() interface{} {
+			return map[string]int{"b": 1}
+		}, `expected map of {"a": exactly 1}, but key "a" is missing`),
+		Entry("for MapOf with a value that does not match", MapOf(map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}), func() interface{} {
+			return map[string]int{"a": 2}
+		}, `key "a": expected exactly 1, got 2`),
+		Entry("for MapContaining with a missing key", MapContaining("a", 1), func() interface{} { return map[string]int{} },
+			`expected map containing {"a": 1}, but key "a" is missing`),
+		Entry("for MapContaining with a value that does not match", MapContaining("a", 1), func() interface{} { return map[string]int{"a": 2} },
+			`key "a": expected 1, got 2`),
+		Entry("for PointsTo with a pointee that does not match", PointsTo(Exactly(1)), func() interface{} {
+			v := 2
+			p := &v
+			return &p
+		}, "pointee: expected exactly 1, got 2"),
+		Entry("for PointsTo with a nil pointee", PointsTo(Exactly(1)), func() interface{} {
+			var p *int
+			return &p
+		}, "expected pointer to exactly 1, got a pointer to a nil *int"),
+		Entry("for PointsTo with a pointer that points to itself", PointsTo(Exactly(1)), func() interface{} {
+			type cycle *interface{}
+			var v interface{}
+			v = cycle(&v)
+			return &v
+		}, "expected pointer to exactly 1, got a pointer that points to itself"),
+		Entry("for JSONEq with invalid JSON", JSONEq(`{"a":1}`), func() interface{} { return `{"a":` },
+			`expected JSON equal to {"a":1}, got invalid JSON "{\"a\":"`),
+		Entry("for JSONPath with a missing path", JSONPath("a.b", 1), func() interface{} { return `{"a":{}}` },
+			`expected JSON with "a.b" matching 1, but "a.b" does not exist in "{\"a\":{}}"`),
+		Entry("for JSONPath with a value that does not match", JSONPath("a", StringPrefix("x")), func() interface{} { return `{"a":"y"}` },
+			`"a": expected string with prefix "x", got "y"`),
+		Entry("for ReaderContent with content that does not match", ReaderContent("a"), func() interface{} { return strings.NewReader("b") },
+			`content: expected "a", got "b"`),
+		Entry("for ReaderContent with a reader that cannot be read", ReaderContent("a"), func() interface{} { return io.MultiReader() },
+			`expected reader with content matching "a", got *io.multiReader which cannot be read without consuming it`),
+		Entry("for TimeZone with a time in another location", TimeZone(time.UTC), func() interface{} {
+			return time.Date(2021, 6, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+		}, `expected time in location "UTC", got 2021-06-01 12:00:00 -0500 EST in location "EST"`),
+		Entry("for HTTPRequest with a different method", HTTPRequest().Method("POST"), func() interface{} {
+			return &http.Request{Method: "GET"}
+		}, `expected method "POST", got "GET"`),
+		Entry("for HTTPRequest with a different path", HTTPRequest().Path("/v1/*"), func() interface{} {
+			r, _ := http.NewRequest("GET", "http://localhost/v2/x", nil)
+			return r
+		}, `expected path matching "/v1/*", got "/v2/x"`),
+		Entry("for HTTPRequest with a header that does not match", HTTPRequest().Header("Authorization", StringPrefix("Bearer ")), func() interface{} {
+			r, _ := http.NewRequest("GET", "http://localhost/", nil)
+			r.Header.Set("Authorization", "Basic x")
+			return r
+		}, `expected header "Authorization" matching string with prefix "Bearer ", got []string{"Basic x"}`),
+		Entry("for HTTPRequest with a query that does not match", HTTPRequest().Query("page", "2"), func() interface{} {
+			r, _ := http.NewRequest("GET", "http://localhost/?page=3", nil)
+			return r
+		}, `expected query "page" matching "2", got []string{"3"}`),
+		Entry("for HTTPRequest with a body that does not match", HTTPRequest().Body(JSONPath("id", 7)), func() interface{} {
+			r, _ := http.NewRequest("POST", "http://localhost/", strings.NewReader(`{"id":8}`))
+			return r
+		}, `body: "id": expected 7, got 8`),
+		Entry("for HTTPRequest with a value that is not a request", HTTPRequest(), func() interface{} { return "GET /" },
+			`expected *http.Request, got "GET /"`),
+		Entry("for a float", NaN(), func() interface{} { return math.Inf(1) },
+			"expected NaN, got +Inf"),
+		Entry("for a duration", DurationBetween(time.Second, time.Minute), func() interface{} { return time.Hour },
+			"expected duration between 1s and 1m0s, got 1h0m0s"),
+	)
+})
@@ -0,0 +1,44 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// DurationBetween returns a new matcher that will match durations between the
+// provided lower and upper bounds, inclusive. Only time.Duration values are
+// matched, not other int64 values.
+func DurationBetween(lower time.Duration, upper time.Duration) SupportedKindsMatcher {
+	return &durationBetween{lower, upper}
+}
+
+type durationBetween struct {
+	lower time.Duration
+	upper time.Duration
+}
+
+// SupportedKinds returns all the kinds the duration between matcher supports
+func (durationBetween) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Int64:     {},
+		reflect.Interface: {},
+	}
+}
+
+// Match returns true if actual is a time.Duration that is greater than or equal
+// to the lower bound and less than or equal to the upper bound; otherwise false
+func (m *durationBetween) Match(value interface{}) bool {
+	d, ok := value.(time.Duration)
+	return ok && d >= m.lower && d <= m.upper
+}
+
+// String returns a description of what the duration between matcher expects
+func (m *durationBetween) String() string {
+	return fmt.Sprintf("duration between %v and %v", m.lower, m.upper)
+}
+
+// Explain returns an explanation of why the value does not match the duration between matcher
+func (m *durationBetween) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,49 @@
+package match
+
+import (
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("durationBetween", func() {
+	Describe("DurationBetween", func() {
+		It("returns a durationBetween struct", func() {
+			actual := DurationBetween(time.Second, time.Minute)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(durationBetween)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns int64 and interface kinds", func() {
+			gomega.Expect(DurationBetween(time.Second, time.Minute).SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Int64:     {},
+					reflect.Interface: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(actual interface{}) {
+			gomega.Expect(DurationBetween(time.Second, time.Minute).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is the lower bound", time.Second),
+		Entry("when actual is the upper bound", time.Minute),
+		Entry("when actual is between the bounds", 30*time.Second),
+	)
+
+	DescribeTable("Match returns false",
+		func(actual interface{}) {
+			gomega.Expect(DurationBetween(time.Second, time.Minute).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is an int64", int64(30*time.Second)),
+		Entry("when actual is less than the lower bound", time.Second-time.Nanosecond),
+		Entry("when actual is greater than the upper bound", time.Minute+time.Nanosecond),
+	)
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -51,3 +52,13 @@ func (m *elementsContaining) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// String returns a description of what the elements containing matcher expects
+func (m *elementsContaining) String() string {
+	return fmt.Sprintf("elements containing (%v)", describeValues(m.elements))
+}
+
+// Explain returns an explanation of why the value does not match the elements containing matcher
+func (m *elementsContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("elementsContaining", func() {
@@ -13,7 +13,7 @@ var _ = Describe("elementsContaining", func() {
 		It("returns an elementsContaining struct", func() {
 			actual := ElementsContaining(2)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(elementsContaining)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(elementsContaining)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("elementsContaining", func() {
 		It("returns all support kinds in go", func() {
 			actual := ElementsContaining(3).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Array: {},
 					reflect.Slice: {},
@@ -31,7 +31,7 @@ var _ = Describe("elementsContaining", func() {
 
 	DescribeTable("Match returns true",
 		func(elements []interface{}, actual interface{}) {
-			Expect(ElementsContaining(elements...).Match(actual)).To(BeTrue())
+			gomega.Expect(ElementsContaining(elements...).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when the elements and slice are empty", []interface{}{}, []int{}),
 		Entry("when the elements and array are empty", []interface{}{}, [0]string{}),
@@ -45,7 +45,7 @@ var _ = Describe("elementsContaining", func() {
 
 	DescribeTable("Match returns false",
 		func(elements []interface{}, actual interface{}) {
-			Expect(ElementsContaining(elements...).Match(actual)).To(BeFalse())
+			gomega.Expect(ElementsContaining(elements...).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", []interface{}{1}, nil),
 		Entry("when actual is not a valid kind", []interface{}{"1"}, 123),
@@ -28,13 +28,21 @@ func (empty) Match(value interface{}) bool {
 	}
 
 	switch v := reflect.ValueOf(value); v.Kind() {
-	case reflect.Array, reflect.Slice:
+	case reflect.Array, reflect.Slice, reflect.String:
 		return v.Len() == 0
 	case reflect.Map:
 		return len(v.MapKeys()) == 0
-	case reflect.String:
-		return len(value.(string)) == 0
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the empty matcher expects
+func (empty) String() string {
+	return "empty value"
+}
+
+// Explain returns an explanation of why the value does not match the empty matcher
+func (m empty) Explain(value interface{}) string {
+	return explain(m, value)
+}
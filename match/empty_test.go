@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("empty", func() {
@@ -13,7 +13,7 @@ var _ = Describe("empty", func() {
 		It("returns an empty struct", func() {
 			actual := Empty()
 
-			Expect(actual).To(BeAssignableToTypeOf(new(empty)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(empty)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("empty", func() {
 		It("returns all support kinds in go", func() {
 			actual := Empty().SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Array:  {},
 					reflect.Map:    {},
@@ -33,17 +33,18 @@ var _ = Describe("empty", func() {
 
 	DescribeTable("Match returns true",
 		func(actual interface{}) {
-			Expect(Empty().Match(actual)).To(BeTrue())
+			gomega.Expect(Empty().Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with empty slice", []int{}),
 		Entry("with empty array", [0]string{}),
 		Entry("with empty string", ""),
 		Entry("with empty map", map[int]string{}),
+		Entry("with empty defined string type", namedString("")),
 	)
 
 	DescribeTable("Match returns false",
 		func(actual interface{}) {
-			Expect(Empty().Match(actual)).To(BeFalse())
+			gomega.Expect(Empty().Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", nil),
 		Entry("when actual is not a valid kind", 123),
@@ -51,5 +52,6 @@ var _ = Describe("empty", func() {
 		Entry("when length != 0 for array", [1]string{"a"}),
 		Entry("when length != 0 for string", "hello"),
 		Entry("when length != 0 for map", map[int]string{0: "a"}),
+		Entry("with non empty defined string type", namedString("a")),
 	)
 })
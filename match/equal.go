@@ -0,0 +1,117 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Equal returns a new matcher that will match values that are deeply equal to
+// the provided value. Without options it behaves like reflect.DeepEqual, except
+// that functions are equal when they have the same code pointer; options relax
+// the comparison, such as ignoring fields or the order of slice elements.
+func Equal(value interface{}, options ...EqualOption) SupportedKindsMatcher {
+	m := &equal{value: value, options: equalOptions{ignoredFields: map[string]struct{}{}}}
+	for _, option := range options {
+		if option != nil {
+			option(&m.options)
+		}
+	}
+
+	return m
+}
+
+// EqualOption changes how the Equal matcher compares values
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	ignoredFields    map[string]struct{}
+	ignoreUnexported bool
+	equateEmpty      bool
+	floatTolerance   float64
+	ignoreOrder      bool
+	equateTimes      bool
+	timeTolerance    time.Duration
+}
+
+// IgnoreFields ignores struct fields when comparing values. A name without a dot
+// ignores the field with that name in any struct, while a dotted path such as
+// "Address.City" ignores the field at that path from the root struct. Pointers,
+// slices, arrays and maps do not add to the path, so "Items.Price" ignores the
+// Price field of every element in the Items field.
+func IgnoreFields(names ...string) EqualOption {
+	return func(o *equalOptions) {
+		for _, name := range names {
+			o.ignoredFields[name] = struct{}{}
+		}
+	}
+}
+
+// IgnoreUnexported ignores unexported struct fields when comparing values.
+// As every field of time.Time is unexported, times are compared as instants.
+func IgnoreUnexported() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+// EquateEmpty treats nil and empty slices and maps of the same type as equal
+func EquateEmpty() EqualOption {
+	return func(o *equalOptions) {
+		o.equateEmpty = true
+	}
+}
+
+// FloatTolerance treats floats and the parts of complex numbers as equal
+// when the difference between them is less than or equal to the tolerance
+func FloatTolerance(tolerance float64) EqualOption {
+	return func(o *equalOptions) {
+		o.floatTolerance = tolerance
+	}
+}
+
+// IgnoreOrder treats slices and arrays as equal when they contain
+// the same elements, the same number of times, in any order
+func IgnoreOrder() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreOrder = true
+	}
+}
+
+// EquateTimes compares time.Time values as instants, ignoring their location and
+// monotonic clock reading, and treats them as equal when the difference between
+// them is less than or equal to the tolerance. Times stored in unexported fields
+// cannot be read as a time.Time, so they are still compared field by field.
+func EquateTimes(tolerance time.Duration) EqualOption {
+	return func(o *equalOptions) {
+		o.equateTimes = true
+		o.timeTolerance = tolerance
+	}
+}
+
+type equal struct {
+	value   interface{}
+	options equalOptions
+}
+
+// SupportedKinds returns all the kinds the equal matcher supports
+func (equal) SupportedKinds() map[reflect.Kind]struct{} {
+	return allKinds()
+}
+
+// Match returns true when actual is deeply equal to the
+// provided value using the options; otherwise false
+func (m *equal) Match(value interface{}) bool {
+	c := &equalComparer{&m.options, map[equalVisit]struct{}{}}
+	return c.equal(reflect.ValueOf(m.value), reflect.ValueOf(value), "")
+}
+
+// String returns a description of what the equal matcher expects
+func (m *equal) String() string {
+	return fmt.Sprintf("value equal to %v", formatValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the equal matcher
+func (m *equal) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,225 @@
+package match
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// equalComparer compares values deeply using the options of the Equal matcher
+type equalComparer struct {
+	options  *equalOptions
+	visiting map[equalVisit]struct{}
+}
+
+// equalVisit is a pair of references of the same type that are being compared,
+// used to treat cyclic references as equal instead of recursing forever
+type equalVisit struct {
+	expected uintptr
+	actual   uintptr
+	t        reflect.Type
+}
+
+// equal returns true if the values are deeply equal; path is the dotted
+// path of struct field names from the root value used to ignore fields
+func (c *equalComparer) equal(expected reflect.Value, actual reflect.Value, path string) bool {
+	if !expected.IsValid() || !actual.IsValid() {
+		return expected.IsValid() == actual.IsValid()
+	}
+
+	if expected.Type() != actual.Type() {
+		return false
+	}
+
+	// every field of time.Time is unexported, so times are compared as
+	// instants rather than ignored entirely when ignoring unexported fields
+	if (c.options.equateTimes || c.options.ignoreUnexported) && expected.Type() == timeType && expected.CanInterface() && actual.CanInterface() {
+		return c.equalTimes(expected.Interface().(time.Time), actual.Interface().(time.Time))
+	}
+
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Float32, reflect.Float64:
+		return c.equalFloats(expected.Float(), actual.Float())
+	case reflect.Complex64, reflect.Complex128:
+		e, a := expected.Complex(), actual.Complex()
+		return c.equalFloats(real(e), real(a)) && c.equalFloats(imag(e), imag(a))
+	case reflect.String:
+		return expected.String() == actual.String()
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return expected.Pointer() == actual.Pointer()
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			return expected.IsNil() == actual.IsNil()
+		}
+
+		return c.equal(expected.Elem(), actual.Elem(), path)
+	case reflect.Ptr:
+		if expected.Pointer() == actual.Pointer() {
+			return true
+		}
+
+		if expected.IsNil() || actual.IsNil() {
+			return false
+		}
+
+		return c.visit(expected, actual, func() bool {
+			return c.equal(expected.Elem(), actual.Elem(), path)
+		})
+	case reflect.Array:
+		return c.equalElements(expected, actual, path)
+	case reflect.Slice:
+		if empty, equal := c.equalEmpty(expected, actual); empty {
+			return equal
+		}
+
+		if expected.Pointer() == actual.Pointer() && expected.Len() == actual.Len() {
+			return true
+		}
+
+		return c.visit(expected, actual, func() bool {
+			return c.equalElements(expected, actual, path)
+		})
+	case reflect.Map:
+		if empty, equal := c.equalEmpty(expected, actual); empty {
+			return equal
+		}
+
+		if expected.Pointer() == actual.Pointer() {
+			return true
+		}
+
+		return c.visit(expected, actual, func() bool {
+			return c.equalMaps(expected, actual, path)
+		})
+	case reflect.Struct:
+		return c.equalStructs(expected, actual, path)
+	default:
+		return false
+	}
+}
+
+// visit returns the result of comparing the references, or true if they
+// are already being compared further up because the values are cyclic
+func (c *equalComparer) visit(expected reflect.Value, actual reflect.Value, compare func() bool) bool {
+	v := equalVisit{expected.Pointer(), actual.Pointer(), expected.Type()}
+	if _, ok := c.visiting[v]; ok {
+		return true
+	}
+
+	c.visiting[v] = struct{}{}
+	defer delete(c.visiting, v)
+
+	return compare()
+}
+
+// equalFloats returns true if the floats are equal or within the tolerance
+func (c *equalComparer) equalFloats(expected float64, actual float64) bool {
+	return expected == actual || math.Abs(expected-actual) <= c.options.floatTolerance
+}
+
+// equalTimes returns true if the times are the same instant or within the tolerance
+func (c *equalComparer) equalTimes(expected time.Time, actual time.Time) bool {
+	return !actual.Before(expected.Add(-c.options.timeTolerance)) && !actual.After(expected.Add(c.options.timeTolerance))
+}
+
+// equalEmpty returns true and whether the slices or maps are equal if either is
+// nil or empty, as nil and empty values are only equal when equating empty
+func (c *equalComparer) equalEmpty(expected reflect.Value, actual reflect.Value) (bool, bool) {
+	if expected.Len() != 0 && actual.Len() != 0 {
+		return false, false
+	}
+
+	if expected.Len() != actual.Len() {
+		return true, false
+	}
+
+	return true, c.options.equateEmpty || expected.IsNil() == actual.IsNil()
+}
+
+// equalElements returns true if the slices or arrays have equal elements,
+// in the same order unless the order is ignored
+func (c *equalComparer) equalElements(expected reflect.Value, actual reflect.Value, path string) bool {
+	if expected.Len() != actual.Len() {
+		return false
+	}
+
+	if !c.options.ignoreOrder {
+		for i := 0; i < expected.Len(); i++ {
+			if !c.equal(expected.Index(i), actual.Index(i), path) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	matches := make([][]int, expected.Len())
+	for i := range matches {
+		for j := 0; j < actual.Len(); j++ {
+			if c.equal(expected.Index(i), actual.Index(j), path) {
+				matches[i] = append(matches[i], j)
+			}
+		}
+
+		if len(matches[i]) == 0 {
+			return false
+		}
+	}
+
+	return maximumMatching(matches, actual.Len()) == expected.Len()
+}
+
+// equalMaps returns true if the maps have the same keys with equal values
+func (c *equalComparer) equalMaps(expected reflect.Value, actual reflect.Value, path string) bool {
+	if expected.Len() != actual.Len() {
+		return false
+	}
+
+	for _, key := range expected.MapKeys() {
+		value := actual.MapIndex(key)
+		if !value.IsValid() || !c.equal(expected.MapIndex(key), value, path) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equalStructs returns true if the structs have equal fields, skipping ignored fields
+func (c *equalComparer) equalStructs(expected reflect.Value, actual reflect.Value, path string) bool {
+	t := expected.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		if c.ignored(field, fieldPath) {
+			continue
+		}
+
+		if !c.equal(expected.Field(i), actual.Field(i), fieldPath) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ignored returns true if the struct field at the path should not be compared
+func (c *equalComparer) ignored(field reflect.StructField, path string) bool {
+	if c.options.ignoreUnexported && field.PkgPath != "" {
+		return true
+	}
+
+	_, byName := c.options.ignoredFields[field.Name]
+	_, byPath := c.options.ignoredFields[path]
+	return byName || byPath
+}
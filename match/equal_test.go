@@ -0,0 +1,189 @@
+package match
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+type equalItem struct {
+	Name      string
+	Price     float64
+	Tags      []string
+	UpdatedAt time.Time
+	note      string
+}
+
+type equalOrder struct {
+	ID        int
+	Items     []equalItem
+	Metadata  map[string]interface{}
+	Parent    *equalOrder
+	UpdatedAt time.Time
+	callback  func()
+}
+
+var _ = Describe("equal", func() {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	callback := func() {}
+
+	Describe("Equal", func() {
+		It("returns an equal struct", func() {
+			actual := Equal(1)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(equal)))
+		})
+
+		It("ignores nil options", func() {
+			gomega.Expect(Equal(1, nil).Match(1)).To(gomega.BeTrue())
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the kinds", func() {
+			gomega.Expect(Equal(1).SupportedKinds()).To(gomega.Equal(allKinds()))
+		})
+	})
+
+	Describe("Match", func() {
+		It("treats cyclic values as equal", func() {
+			expected := &equalOrder{ID: 1}
+			expected.Parent = expected
+			actual := &equalOrder{ID: 1}
+			actual.Parent = actual
+
+			gomega.Expect(Equal(expected).Match(actual)).To(gomega.BeTrue())
+		})
+
+		It("does not treat cyclic values with different fields as equal", func() {
+			expected := &equalOrder{ID: 1}
+			expected.Parent = expected
+			actual := &equalOrder{ID: 1, Parent: &equalOrder{ID: 2}}
+			actual.Parent.Parent = actual
+
+			gomega.Expect(Equal(expected).Match(actual)).To(gomega.BeFalse())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected interface{}, actual interface{}, options ...EqualOption) {
+			gomega.Expect(Equal(expected, options...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when both are nil", nil, nil),
+		Entry("when the ints are equal", 1, 1),
+		Entry("when the strings are equal", "mocka", "mocka"),
+		Entry("when the complex numbers are equal", complex(1, 2), complex(1, 2)),
+		Entry("when the structs are deeply equal",
+			equalOrder{ID: 1, Items: []equalItem{{Name: "a", note: "x"}}, Metadata: map[string]interface{}{"a": []int{1}}},
+			equalOrder{ID: 1, Items: []equalItem{{Name: "a", note: "x"}}, Metadata: map[string]interface{}{"a": []int{1}}}),
+		Entry("when the pointees are equal", &equalItem{Name: "a"}, &equalItem{Name: "a"}),
+		Entry("when the functions are the same", callback, callback),
+		Entry("when the unexported functions are the same", equalOrder{callback: callback}, equalOrder{callback: callback}),
+		Entry("when both slices are nil", []int(nil), []int(nil)),
+		Entry("when both slices are empty", []int{}, []int{}),
+		Entry("when an ignored field is different",
+			equalItem{Name: "a", UpdatedAt: created},
+			equalItem{Name: "a", UpdatedAt: created.Add(time.Hour)},
+			IgnoreFields("UpdatedAt")),
+		Entry("when a field with an ignored name is different in a nested struct",
+			equalOrder{Items: []equalItem{{Name: "a", UpdatedAt: created}}},
+			equalOrder{Items: []equalItem{{Name: "a"}}},
+			IgnoreFields("UpdatedAt")),
+		Entry("when a field with an ignored path is different",
+			equalOrder{Items: []equalItem{{Name: "a", Price: 1}}},
+			equalOrder{Items: []equalItem{{Name: "a", Price: 2}}},
+			IgnoreFields("Items.Price")),
+		Entry("when an unexported field is different and unexported fields are ignored",
+			equalItem{Name: "a", note: "x"},
+			equalItem{Name: "a", note: "y"},
+			IgnoreUnexported()),
+		Entry("when a nil slice is compared to an empty slice and empty is equated", []int(nil), []int{}, EquateEmpty()),
+		Entry("when a nil map is compared to an empty map and empty is equated",
+			equalOrder{Metadata: map[string]interface{}{}},
+			equalOrder{},
+			EquateEmpty()),
+		Entry("when floats are within the tolerance", 0.3, 0.30000000000000004, FloatTolerance(1e-9)),
+		Entry("when float32s are within the tolerance", float32(1), float32(1.05), FloatTolerance(0.1)),
+		Entry("when infinite floats are equal with a tolerance", math.Inf(1), math.Inf(1), FloatTolerance(0.1)),
+		Entry("when complex numbers are within the tolerance", complex(1, 2), complex(1.05, 1.95), FloatTolerance(0.1)),
+		Entry("when nested floats are within the tolerance",
+			equalOrder{Items: []equalItem{{Price: 9.99}}},
+			equalOrder{Items: []equalItem{{Price: 9.990001}}},
+			FloatTolerance(0.0001)),
+		Entry("when the slice order is different and order is ignored", []int{1, 2, 2, 3}, []int{2, 3, 1, 2}, IgnoreOrder()),
+		Entry("when the array order is different and order is ignored", [2]string{"a", "b"}, [2]string{"b", "a"}, IgnoreOrder()),
+		Entry("when nested slice order is different and order is ignored",
+			equalOrder{Items: []equalItem{{Name: "a", Tags: []string{"x", "y"}}, {Name: "b"}}},
+			equalOrder{Items: []equalItem{{Name: "b"}, {Name: "a", Tags: []string{"y", "x"}}}},
+			IgnoreOrder()),
+		Entry("when the times are the same instant in different locations",
+			created,
+			created.In(time.FixedZone("EST", -5*60*60)),
+			EquateTimes(0)),
+		Entry("when nested times are the same instant and unexported fields are ignored",
+			equalOrder{ID: 1, UpdatedAt: created},
+			equalOrder{ID: 1, UpdatedAt: created.In(time.FixedZone("EST", -5*60*60))},
+			IgnoreUnexported()),
+		Entry("when nested times are within the tolerance",
+			equalOrder{ID: 1, UpdatedAt: created},
+			equalOrder{ID: 1, UpdatedAt: created.Add(time.Millisecond)},
+			EquateTimes(time.Second)),
+		Entry("when everything differs in ways that are ignored",
+			equalOrder{ID: 1, Items: []equalItem{{Name: "a", Price: 1, note: "x"}, {Name: "b", Tags: []string{}}}, UpdatedAt: created},
+			equalOrder{ID: 1, Items: []equalItem{{Name: "b"}, {Name: "a", Price: 1.001, note: "y"}}, UpdatedAt: created.Add(time.Second)},
+			IgnoreUnexported(), EquateEmpty(), FloatTolerance(0.01), IgnoreOrder(), EquateTimes(time.Minute)),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected interface{}, actual interface{}, options ...EqualOption) {
+			gomega.Expect(Equal(expected, options...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1, nil),
+		Entry("when the expected value is nil", nil, 1),
+		Entry("when the types are different", 1, int64(1)),
+		Entry("when the ints are different", 1, 2),
+		Entry("when the bools are different", true, false),
+		Entry("when the uints are different", uint(1), uint(2)),
+		Entry("when the strings are different", "mocka", "gomock"),
+		Entry("when the floats are different", 0.3, 0.30000000000000004),
+		Entry("when the floats are NaN", math.NaN(), math.NaN()),
+		Entry("when the complex numbers are different", complex(1, 2), complex(1, 3)),
+		Entry("when the functions are different", callback, func() {}),
+		Entry("when the channels are different", make(chan int), make(chan int)),
+		Entry("when a pointer is nil", &equalItem{}, (*equalItem)(nil)),
+		Entry("when the pointees are different", &equalItem{Name: "a"}, &equalItem{Name: "b"}),
+		Entry("when an interface is nil", []interface{}{1}, []interface{}{nil}),
+		Entry("when the slice lengths are different", []int{1}, []int{1, 2}),
+		Entry("when the slice order is different", []int{1, 2}, []int{2, 1}),
+		Entry("when a nil slice is compared to an empty slice", []int(nil), []int{}),
+		Entry("when a nil map is compared to an empty map", map[string]int(nil), map[string]int{}),
+		Entry("when the map lengths are different", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}),
+		Entry("when a map key is missing", map[string]int{"a": 1}, map[string]int{"b": 1}),
+		Entry("when a map value is different", map[string]int{"a": 1}, map[string]int{"a": 2}),
+		Entry("when an unexported field is different", equalItem{note: "x"}, equalItem{note: "y"}),
+		Entry("when a field that is not ignored is different",
+			equalItem{Name: "a", UpdatedAt: created},
+			equalItem{Name: "b", UpdatedAt: created.Add(time.Hour)},
+			IgnoreFields("UpdatedAt")),
+		Entry("when the ignored path does not match the field",
+			equalOrder{Items: []equalItem{{Price: 1}}},
+			equalOrder{Items: []equalItem{{Price: 2}}},
+			IgnoreFields("Parent.Price")),
+		Entry("when an empty slice is compared to a non-empty slice and empty is equated", []int{}, []int{1}, EquateEmpty()),
+		Entry("when floats are outside the tolerance", 1.0, 1.2, FloatTolerance(0.1)),
+		Entry("when the elements are different and order is ignored", []int{1, 2, 2}, []int{1, 1, 2}, IgnoreOrder()),
+		Entry("when the times are in different locations", created, created.In(time.FixedZone("EST", -5*60*60))),
+		Entry("when the times are outside the tolerance", created, created.Add(time.Minute), EquateTimes(time.Second)),
+		Entry("when the times are different and unexported fields are ignored",
+			created,
+			time.Date(1999, 6, 1, 12, 0, 0, 0, time.UTC),
+			IgnoreUnexported()),
+		Entry("when nested times are different and unexported fields are ignored",
+			equalOrder{ID: 1, UpdatedAt: created},
+			equalOrder{ID: 1, UpdatedAt: time.Date(1999, 6, 1, 12, 0, 0, 0, time.UTC)},
+			IgnoreUnexported()),
+	)
+})
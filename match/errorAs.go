@@ -0,0 +1,63 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrorAs returns a new matcher that will match errors when errors.As finds
+// an error that can be assigned to the provided target, which must be a
+// non-nil pointer to a type that implements error or to an interface. Like
+// errors.As the matching error is stored in the target.
+func ErrorAs(target interface{}) SupportedKindsMatcher {
+	return &errorAs{target}
+}
+
+type errorAs struct {
+	target interface{}
+}
+
+// SupportedKinds returns all the kinds the error as matcher supports;
+// no kinds are supported if the target is not valid for errors.As
+func (m *errorAs) SupportedKinds() map[reflect.Kind]struct{} {
+	if !isErrorAsTarget(m.target) {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return errorKinds()
+}
+
+// Match returns true if the error or any error it wraps can be
+// assigned to the provided target; otherwise false
+func (m *errorAs) Match(value interface{}) bool {
+	err, ok := value.(error)
+	if !ok || err == nil || !isErrorAsTarget(m.target) {
+		return false
+	}
+
+	return errors.As(err, m.target)
+}
+
+// String returns a description of what the error as matcher expects
+func (m *errorAs) String() string {
+	return fmt.Sprintf("error as %v", describeType(m.target))
+}
+
+// Explain returns an explanation of why the value does not match the error as matcher
+func (m *errorAs) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// isErrorAsTarget returns true if the target can be used with errors.As without panicking
+func isErrorAsTarget(target interface{}) bool {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+
+	elem := v.Type().Elem()
+	return elem.Kind() == reflect.Interface || elem.Implements(errorType)
+}
@@ -0,0 +1,71 @@
+package match
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("errorAs", func() {
+	Describe("ErrorAs", func() {
+		It("returns an errorAs struct", func() {
+			actual := ErrorAs(new(*typedError))
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(errorAs)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ErrorAs(new(*typedError)).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+
+		DescribeTable("returns no kinds when the target is invalid",
+			func(target interface{}) {
+				gomega.Expect(ErrorAs(target).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("nil", nil),
+			Entry("not a pointer", typedError{}),
+			Entry("nil pointer", (**typedError)(nil)),
+			Entry("pointer to a type that is not an error", new(string)),
+		)
+	})
+
+	It("Match stores the matching error in the target", func() {
+		var target *typedError
+		err := &typedError{code: 404}
+
+		gomega.Expect(ErrorAs(&target).Match(fmt.Errorf("request: %w", err))).To(gomega.BeTrue())
+		gomega.Expect(target).To(gomega.BeIdenticalTo(err))
+	})
+
+	DescribeTable("Match returns true",
+		func(target interface{}, actual interface{}) {
+			gomega.Expect(ErrorAs(target).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the error has the target type", new(*typedError), &typedError{}),
+		Entry("when the error wraps the target type", new(*typedError), fmt.Errorf("request: %w", &typedError{})),
+		Entry("when the error implements the target interface", new(net.Error), &net.DNSError{}),
+	)
+
+	DescribeTable("Match returns false",
+		func(target interface{}, actual interface{}) {
+			gomega.Expect(ErrorAs(target).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", new(*typedError), nil),
+		Entry("when actual is not an error", new(*typedError), "typed error"),
+		Entry("when the error does not have the target type", new(*typedError), io.EOF),
+		Entry("when the target is invalid", new(string), &typedError{}),
+	)
+})
@@ -0,0 +1,42 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrorContaining returns a new matcher that will match errors
+// whose message contains the provided substring
+func ErrorContaining(substring string) SupportedKindsMatcher {
+	return &errorContaining{substring}
+}
+
+type errorContaining struct {
+	substring string
+}
+
+// SupportedKinds returns all the kinds the error containing matcher supports
+func (errorContaining) SupportedKinds() map[reflect.Kind]struct{} {
+	return errorKinds()
+}
+
+// Match returns true if the error message contains the provided substring; otherwise false
+func (m *errorContaining) Match(value interface{}) bool {
+	err, ok := value.(error)
+	if !ok || err == nil {
+		return false
+	}
+
+	return strings.Contains(err.Error(), m.substring)
+}
+
+// String returns a description of what the error containing matcher expects
+func (m *errorContaining) String() string {
+	return fmt.Sprintf("error containing %q", m.substring)
+}
+
+// Explain returns an explanation of why the value does not match the error containing matcher
+func (m *errorContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,50 @@
+package match
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("errorContaining", func() {
+	Describe("ErrorContaining", func() {
+		It("returns an errorContaining struct", func() {
+			actual := ErrorContaining("")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(errorContaining)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ErrorContaining("").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(substring string, actual interface{}) {
+			gomega.Expect(ErrorContaining(substring).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the message contains the substring", "EOF", fmt.Errorf("reading: %w", io.EOF)),
+		Entry("when the error is a pointer", "404", &typedError{code: 404}),
+	)
+
+	DescribeTable("Match returns false",
+		func(substring string, actual interface{}) {
+			gomega.Expect(ErrorContaining(substring).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", "EOF", nil),
+		Entry("when actual is not an error", "EOF", "EOF"),
+		Entry("when the message does not contain the substring", "timeout", io.EOF),
+	)
+})
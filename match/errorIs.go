@@ -0,0 +1,55 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrorIs returns a new matcher that will match errors that match the
+// provided target using errors.Is, which unwraps wrapped errors
+func ErrorIs(target error) SupportedKindsMatcher {
+	return &errorIs{target}
+}
+
+type errorIs struct {
+	target error
+}
+
+// SupportedKinds returns all the kinds the error is matcher supports
+func (errorIs) SupportedKinds() map[reflect.Kind]struct{} {
+	return errorKinds()
+}
+
+// Match returns true if the error or any error it wraps is the provided
+// target; otherwise false. A nil value only matches a nil target.
+func (m *errorIs) Match(value interface{}) bool {
+	if value == nil {
+		return m.target == nil
+	}
+
+	err, ok := value.(error)
+	if !ok {
+		return false
+	}
+
+	return errors.Is(err, m.target)
+}
+
+// String returns a description of what the error is matcher expects
+func (m *errorIs) String() string {
+	return fmt.Sprintf("error is %v", formatValue(m.target))
+}
+
+// Explain returns an explanation of why the value does not match the error is matcher
+func (m *errorIs) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// errorKinds returns the kinds of the values that can be matched as errors
+func errorKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+	}
+}
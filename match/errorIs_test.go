@@ -0,0 +1,62 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+type typedError struct {
+	code int
+}
+
+func (e *typedError) Error() string {
+	return fmt.Sprintf("typed error %v", e.code)
+}
+
+var _ = Describe("errorIs", func() {
+	Describe("ErrorIs", func() {
+		It("returns an errorIs struct", func() {
+			actual := ErrorIs(io.EOF)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(errorIs)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ErrorIs(io.EOF).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(target error, actual interface{}) {
+			gomega.Expect(ErrorIs(target).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the error is the target", io.EOF, io.EOF),
+		Entry("when the error wraps the target", io.EOF, fmt.Errorf("reading: %w", io.EOF)),
+		Entry("when the error and target are nil", nil, nil),
+	)
+
+	DescribeTable("Match returns false",
+		func(target error, actual interface{}) {
+			gomega.Expect(ErrorIs(target).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", io.EOF, nil),
+		Entry("when actual is not an error", io.EOF, "EOF"),
+		Entry("when the error is a different error", io.EOF, io.ErrUnexpectedEOF),
+		Entry("when the error has the same message", io.EOF, errors.New("EOF")),
+		Entry("when the target is nil", nil, io.EOF),
+	)
+})
@@ -0,0 +1,65 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Every returns a new matcher that will match slices and arrays where every
+// element matches the provided element, which can be a matcher or a value
+// that is compared using reflect.DeepEqual. Empty slices and arrays match, and
+// channels are not supported, like for ConsistsOf.
+func Every(element interface{}) SupportedKindsMatcher {
+	return &every{element}
+}
+
+type every struct {
+	element interface{}
+}
+
+// SupportedKinds returns all the kinds the every matcher supports
+func (every) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Slice: {},
+		reflect.Array: {},
+	}
+}
+
+// Match return true if every element in the slice or array matches; otherwise false
+func (m *every) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !matchesValue(m.element, v.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the every matcher expects
+func (m *every) String() string {
+	return fmt.Sprintf("every element matching %v", describeValue(m.element))
+}
+
+// Explain returns an explanation of the first element that does not match the every matcher
+func (m *every) Explain(value interface{}) string {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !matchesValue(m.element, v.Index(i)) {
+				return fmt.Sprintf("element %v: %v", i, explainValue(m.element, v.Index(i)))
+			}
+		}
+	}
+
+	return explain(m, value)
+}
@@ -0,0 +1,53 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("every", func() {
+	Describe("Every", func() {
+		It("returns an every struct", func() {
+			actual := Every(1)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(every)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := Every(1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Slice: {},
+					reflect.Array: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(element interface{}, actual interface{}) {
+			gomega.Expect(Every(element).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the slice is empty", 1, []int{}),
+		Entry("when every value is the same", 1, []int{1, 1}),
+		Entry("when every value matches the matcher", GreaterThan(0), [3]int{1, 2, 3}),
+		Entry("when every map is equal", map[string]int{"a": 1}, []map[string]int{{"a": 1}, {"a": 1}}),
+	)
+
+	DescribeTable("Match returns false",
+		func(element interface{}, actual interface{}) {
+			gomega.Expect(Every(element).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1, nil),
+		Entry("when actual is not a slice", 1, 1),
+		Entry("when a value is different", 1, []int{1, 2}),
+		Entry("when a value does not match the matcher", GreaterThan(1), []int{1, 2, 3}),
+		Entry("when a map is only a subset", map[string]int{"a": 1}, []map[string]int{{"a": 1, "b": 2}}),
+		Entry("when actual is a channel", 1, make(chan int)),
+	)
+})
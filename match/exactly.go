@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -49,3 +50,13 @@ func (exactly) SupportedKinds() map[reflect.Kind]struct{} {
 func (m *exactly) Match(value interface{}) bool {
 	return reflect.DeepEqual(m.value, value)
 }
+
+// String returns a description of what the exactly matcher expects
+func (m *exactly) String() string {
+	return fmt.Sprintf("exactly %v", formatValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the exactly matcher
+func (m *exactly) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("exactly", func() {
@@ -31,7 +31,7 @@ var _ = Describe("exactly", func() {
 		It("returns an exactly struct", func() {
 			actual := Exactly(nil)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(exactly)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(exactly)))
 		})
 	})
 
@@ -39,7 +39,7 @@ var _ = Describe("exactly", func() {
 		It("returns all support kinds in go", func() {
 			actual := Exactly(123).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Bool:          {},
 					reflect.Int:           {},
@@ -73,7 +73,7 @@ var _ = Describe("exactly", func() {
 
 	DescribeTable("Match return true",
 		func(first interface{}, second interface{}) {
-			Expect(Exactly(first).Match(second)).To(BeTrue())
+			gomega.Expect(Exactly(first).Match(second)).To(gomega.BeTrue())
 		},
 		Entry("when both are nils", nil, nil),
 		Entry("when numbers are equal", 123, 123),
@@ -89,7 +89,7 @@ var _ = Describe("exactly", func() {
 
 	DescribeTable("Match return false",
 		func(first interface{}, second interface{}) {
-			Expect(Exactly(first).Match(second)).To(BeFalse())
+			gomega.Expect(Exactly(first).Match(second)).To(gomega.BeFalse())
 		},
 		Entry("when numbers are not equal", 123, 563),
 		Entry("when bools are not equal", true, false),
@@ -0,0 +1,135 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields returns a new matcher that will match structs, or pointers to structs,
+// when each of the named exported fields match their matcher. Fields of nested
+// structs and pointers to structs can be matched using a dot separated path,
+// such as "Address.City". Fields that are not provided are ignored.
+func Fields(fields map[string]SupportedKindsMatcher) SupportedKindsMatcher {
+	return &fieldsMatcher{fields}
+}
+
+type fieldsMatcher struct {
+	fields map[string]SupportedKindsMatcher
+}
+
+// SupportedKinds returns all the kinds the fields matcher supports
+func (fieldsMatcher) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+		reflect.Struct:    {},
+	}
+}
+
+// Match returns true if every provided field exists and matches its matcher;
+// otherwise false. Fields are not matched if their kind, or the kind of the value
+// held by an interface field, is not supported by the matcher or if a pointer
+// along the path is nil.
+func (m *fieldsMatcher) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	for path, matcher := range m.fields {
+		field, ok := fieldByPath(reflect.ValueOf(value), path)
+		if !ok || !field.CanInterface() || matcher == nil {
+			return false
+		}
+
+		if !matchesValue(matcher, field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a description of what the fields matcher expects
+func (m *fieldsMatcher) String() string {
+	paths := make([]interface{}, 0, len(m.fields))
+	matchers := make([]interface{}, 0, len(m.fields))
+	for path, matcher := range m.fields {
+		paths = append(paths, path)
+		matchers = append(matchers, matcher)
+	}
+
+	return fmt.Sprintf("struct with fields {%v}", describeEntries(paths, matchers))
+}
+
+// Explain returns an explanation of the first field, in the order of
+// their paths, that does not match the fields matcher
+func (m *fieldsMatcher) Explain(value interface{}) string {
+	if value == nil {
+		return explain(m, value)
+	}
+
+	paths := make([]string, 0, len(m.fields))
+	for path := range m.fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		field, ok := fieldByPath(reflect.ValueOf(value), path)
+		if !ok || !field.CanInterface() {
+			return fmt.Sprintf("expected %v, but %T has no exported field %q", m, value, path)
+		}
+
+		if matcher := m.fields[path]; matcher == nil || !matchesValue(matcher, field) {
+			return fmt.Sprintf("field %q: %v", path, explainValue(matcher, field))
+		}
+	}
+
+	return explain(m, value)
+}
+
+// fieldByPath returns the exported field for the dot separated path and true
+// if every field along the path exists; otherwise false
+func fieldByPath(v reflect.Value, path string) (reflect.Value, bool) {
+	var ok bool
+	for _, name := range strings.Split(path, ".") {
+		if v, ok = indirect(v); !ok || v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		field, found := v.Type().FieldByName(name)
+		if !found || field.PkgPath != "" {
+			return reflect.Value{}, false
+		}
+
+		// walk the index one field at a time, as promoted fields
+		// may be reached through nil embedded pointers
+		for i, index := range field.Index {
+			if i > 0 {
+				if v, ok = indirect(v); !ok {
+					return reflect.Value{}, false
+				}
+			}
+
+			v = v.Field(index)
+		}
+	}
+
+	return v, true
+}
+
+// indirect returns the value that pointers and interfaces refer to and true;
+// false is returned if a nil pointer or interface is found
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		v = v.Elem()
+	}
+
+	return v, true
+}
@@ -0,0 +1,135 @@
+package match
+
+import (
+	"reflect"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+type fieldsAddress struct {
+	City string
+}
+
+type fieldsAudit struct {
+	CreatedBy string
+}
+
+type fieldsUser struct {
+	*fieldsAudit
+	ID       int
+	Name     string
+	Created  time.Time
+	Address  fieldsAddress
+	Previous *fieldsAddress
+	Extra    interface{}
+	password string
+}
+
+var _ = Describe("fieldsMatcher", func() {
+	var user fieldsUser
+
+	BeforeEach(func() {
+		user = fieldsUser{
+			fieldsAudit: &fieldsAudit{CreatedBy: "admin"},
+			ID:          12,
+			Name:        "mocka",
+			Created:     time.Now(),
+			Address:     fieldsAddress{City: "St. Louis"},
+			Previous:    &fieldsAddress{City: "Chicago"},
+			Extra:       fieldsAddress{City: "Denver"},
+			password:    "secret",
+		}
+	})
+
+	Describe("Fields", func() {
+		It("returns a fieldsMatcher struct", func() {
+			actual := Fields(nil)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(fieldsMatcher)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := Fields(nil).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+					reflect.Struct:    {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(fields map[string]SupportedKindsMatcher, usePointer bool) {
+			var actual interface{} = user
+			if usePointer {
+				actual = &user
+			}
+
+			gomega.Expect(Fields(fields).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when there are no fields", map[string]SupportedKindsMatcher{}, false),
+		Entry("when the fields match", map[string]SupportedKindsMatcher{"ID": Exactly(12), "Name": StringPrefix("mo")}, false),
+		Entry("when the fields of a pointer match", map[string]SupportedKindsMatcher{"ID": Exactly(12)}, true),
+		Entry("when a nested field matches", map[string]SupportedKindsMatcher{"Address.City": Exactly("St. Louis")}, false),
+		Entry("when a field of a nested pointer matches", map[string]SupportedKindsMatcher{"Previous.City": Exactly("Chicago")}, true),
+		Entry("when a field of a nested interface matches", map[string]SupportedKindsMatcher{"Extra.City": Exactly("Denver")}, false),
+		Entry("when a promoted field of an embedded pointer matches", map[string]SupportedKindsMatcher{"CreatedBy": Exactly("admin")}, false),
+		Entry("when a nested fields matcher matches", map[string]SupportedKindsMatcher{"Previous": Fields(map[string]SupportedKindsMatcher{"City": Exactly("Chicago")})}, false),
+	)
+
+	DescribeTable("Match returns false",
+		func(fields map[string]SupportedKindsMatcher) {
+			gomega.Expect(Fields(fields).Match(user)).To(gomega.BeFalse())
+		},
+		Entry("when a field does not match", map[string]SupportedKindsMatcher{"ID": Exactly(12), "Name": StringPrefix("ab")}),
+		Entry("when a field does not exist", map[string]SupportedKindsMatcher{"Email": Anything()}),
+		Entry("when a field is not exported", map[string]SupportedKindsMatcher{"password": Anything()}),
+		Entry("when a nested field does not exist", map[string]SupportedKindsMatcher{"Address.Street": Anything()}),
+		Entry("when the path goes through a non struct", map[string]SupportedKindsMatcher{"Name.Length": Anything()}),
+		Entry("when the matcher does not support the field kind", map[string]SupportedKindsMatcher{"ID": StringPrefix("1")}),
+		Entry("when the matcher is nil", map[string]SupportedKindsMatcher{"ID": nil}),
+	)
+
+	It("Match returns true when the value held by an interface field matches", func() {
+		user.Extra = "abc"
+		matcher := Fields(map[string]SupportedKindsMatcher{"Extra": StringPrefix("ab")})
+
+		gomega.Expect(matcher.Match(user)).To(gomega.BeTrue())
+	})
+
+	It("Match returns false when the value held by an interface field does not match", func() {
+		user.Extra = "xyz"
+		matcher := Fields(map[string]SupportedKindsMatcher{"Extra": StringPrefix("ab")})
+
+		gomega.Expect(matcher.Match(user)).To(gomega.BeFalse())
+		gomega.Expect(Explanation(matcher, user)).To(gomega.Equal(`field "Extra": expected string with prefix "ab", got "xyz"`))
+	})
+
+	It("Match returns false when a pointer along the path is nil", func() {
+		user.Previous = nil
+
+		gomega.Expect(Fields(map[string]SupportedKindsMatcher{"Previous.City": Anything()}).Match(user)).To(gomega.BeFalse())
+	})
+
+	It("Match returns false when an embedded pointer is nil", func() {
+		user.fieldsAudit = nil
+
+		gomega.Expect(Fields(map[string]SupportedKindsMatcher{"CreatedBy": Anything()}).Match(user)).To(gomega.BeFalse())
+	})
+
+	DescribeTable("Match returns false for values that are not structs",
+		func(actual interface{}) {
+			gomega.Expect(Fields(map[string]SupportedKindsMatcher{"ID": Anything()}).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is a nil pointer", (*fieldsUser)(nil)),
+		Entry("when actual is not a struct", "mocka"),
+	)
+})
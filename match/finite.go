@@ -0,0 +1,40 @@
+package match
+
+import (
+	"math"
+	"reflect"
+)
+
+// Finite returns a new matcher that will match numbers that are neither
+// infinite nor NaN. Ints and uints are always finite.
+func Finite() SupportedKindsMatcher {
+	return &finite{}
+}
+
+type finite struct {
+}
+
+// SupportedKinds returns all the kinds the finite matcher supports
+func (finite) SupportedKinds() map[reflect.Kind]struct{} {
+	return numericKinds()
+}
+
+// Match returns true if actual is a number that is not infinite or NaN; otherwise false
+func (finite) Match(value interface{}) bool {
+	actual, ok := numericValue(value)
+	if !ok {
+		return false
+	}
+
+	return !actual.isFloat() || !(math.IsInf(actual.f, 0) || math.IsNaN(actual.f))
+}
+
+// String returns a description of what the finite matcher expects
+func (finite) String() string {
+	return "finite number"
+}
+
+// Explain returns an explanation of why the value does not match the finite matcher
+func (m finite) Explain(value interface{}) string {
+	return explain(m, value)
+}
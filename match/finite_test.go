@@ -0,0 +1,49 @@
+package match
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("finite", func() {
+	Describe("Finite", func() {
+		It("returns a finite struct", func() {
+			actual := Finite()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(finite)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the numeric kinds", func() {
+			actual := Finite().SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(numericKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(actual interface{}) {
+			gomega.Expect(Finite().Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("with float64", 1.5),
+		Entry("with float32", float32(math.MaxFloat32)),
+		Entry("with int", math.MinInt64),
+		Entry("with uint", uint64(math.MaxUint64)),
+		Entry("with a defined float type", namedFloat(1.5)),
+	)
+
+	DescribeTable("Match returns false",
+		func(actual interface{}) {
+			gomega.Expect(Finite().Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is not a number", "1"),
+		Entry("when actual is NaN", math.NaN()),
+		Entry("when actual is positive infinity", math.Inf(1)),
+		Entry("when actual is negative infinity", float32(math.Inf(-1))),
+	)
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -27,12 +28,22 @@ func (m *floatGreaterThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
+	switch v := reflect.ValueOf(value); v.Kind() {
 	case reflect.Float32:
-		return value.(float32) >= float32(m.value)
+		return float32(v.Float()) >= float32(m.value)
 	case reflect.Float64:
-		return value.(float64) >= m.value
+		return v.Float() >= m.value
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the float greater than or equal to matcher expects
+func (m *floatGreaterThanOrEqualTo) String() string {
+	return fmt.Sprintf("float greater than or equal to %v", m.value)
+}
+
+// Explain returns an explanation of why the value does not match the float greater than or equal to matcher
+func (m *floatGreaterThanOrEqualTo) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("floatGreaterThanOrEqualTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("floatGreaterThanOrEqualTo", func() {
 		It("returns an floatGreaterThanOrEqualTo struct", func() {
 			actual := FloatGreaterThanOrEqualTo(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(floatGreaterThanOrEqualTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(floatGreaterThanOrEqualTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("floatGreaterThanOrEqualTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := FloatGreaterThanOrEqualTo(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Float32: {},
 					reflect.Float64: {},
@@ -31,21 +31,23 @@ var _ = Describe("floatGreaterThanOrEqualTo", func() {
 
 	DescribeTable("Match returns true",
 		func(expected float64, actual interface{}) {
-			Expect(FloatGreaterThanOrEqualTo(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(FloatGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with float32", float64(20), float32(40)),
 		Entry("with float64", float64(8), float64(15)),
 		Entry("when actual(float32) is the same as the expected", float64(20), float32(20)),
 		Entry("when actual(float64) is the same as the expected", float64(8), float64(8)),
+		Entry("with a defined float type", float64(5), namedFloat(5)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected float64, actual interface{}) {
-			Expect(FloatGreaterThanOrEqualTo(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(FloatGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", float64(5), nil),
 		Entry("when actual(float32) is less than expected", float64(20), float32(4)),
 		Entry("when actual(float64) is less than expected", float64(8), float64(5)),
 		Entry("when actual is not an int", float64(10), "10"),
+		Entry("when actual(defined float type) is less than expected", float64(5), namedFloat(1)),
 	)
 })
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("floatGreaterThan", func() {
@@ -13,7 +13,7 @@ var _ = Describe("floatGreaterThan", func() {
 		It("returns an floatGreaterThan struct", func() {
 			actual := FloatGreaterThan(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(floatGreaterThan)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(floatGreaterThan)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("floatGreaterThan", func() {
 		It("returns all support kinds in go", func() {
 			actual := FloatGreaterThan(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Float32: {},
 					reflect.Float64: {},
@@ -31,15 +31,16 @@ var _ = Describe("floatGreaterThan", func() {
 
 	DescribeTable("Match returns true",
 		func(expected float64, actual interface{}) {
-			Expect(FloatGreaterThan(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(FloatGreaterThan(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with float32", float64(20), float32(40)),
 		Entry("with float64", float64(8), float64(15)),
+		Entry("with a defined float type", float64(5), namedFloat(10)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected float64, actual interface{}) {
-			Expect(FloatGreaterThan(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(FloatGreaterThan(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", float64(5), nil),
 		Entry("when actual(float32) is less than expected", float64(20), float32(4)),
@@ -47,5 +48,7 @@ var _ = Describe("floatGreaterThan", func() {
 		Entry("when actual(float32) is the same as the expected", float64(20), float32(20)),
 		Entry("when actual(float64) is the same as the expected", float64(8), float64(8)),
 		Entry("when actual is not an int", float64(10), "10"),
+		Entry("when actual(defined float type) is less than expected", float64(5), namedFloat(1)),
+		Entry("when actual(float32) is the same as the expected converted to float32", float64(0.1), float32(0.1)),
 	)
 })
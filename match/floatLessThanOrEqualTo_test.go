@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("floatLessThanOrEqualTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("floatLessThanOrEqualTo", func() {
 		It("returns an floatLessThanOrEqualTo struct", func() {
 			actual := FloatLessThanOrEqualTo(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(floatLessThanOrEqualTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(floatLessThanOrEqualTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("floatLessThanOrEqualTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := FloatLessThanOrEqualTo(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Float32: {},
 					reflect.Float64: {},
@@ -31,21 +31,23 @@ var _ = Describe("floatLessThanOrEqualTo", func() {
 
 	DescribeTable("Match returns true",
 		func(expected float64, actual interface{}) {
-			Expect(FloatLessThanOrEqualTo(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(FloatLessThanOrEqualTo(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with float32", float64(40), float32(20)),
 		Entry("with float64", float64(15), float64(8)),
 		Entry("when actual(float32) is the same as the expected", float64(20), float32(20)),
 		Entry("when actual(float64) is the same as the expected", float64(8), float64(8)),
+		Entry("with a defined float type", float64(5), namedFloat(5)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected float64, actual interface{}) {
-			Expect(FloatLessThanOrEqualTo(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(FloatLessThanOrEqualTo(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", float64(5), nil),
 		Entry("when actual(float32) is greater than expected", float64(4), float32(20)),
 		Entry("when actual(float64) is greater than expected", float64(5), float64(8)),
 		Entry("when actual is not an int", float64(10), "10"),
+		Entry("when actual(defined float type) is greater than expected", float64(5), namedFloat(10)),
 	)
 })
@@ -0,0 +1,52 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// GreaterThan returns a new matcher that will match numbers greater than the provided
+// number. Any int, uint or float kind can be matched, including defined types.
+func GreaterThan(value interface{}) SupportedKindsMatcher {
+	return &greaterThan{value}
+}
+
+type greaterThan struct {
+	value interface{}
+}
+
+// SupportedKinds returns all the int, uint and float kinds if the provided
+// value is a number; otherwise no kinds are supported
+func (m *greaterThan) SupportedKinds() map[reflect.Kind]struct{} {
+	if _, ok := numericValue(m.value); !ok {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return numericKinds()
+}
+
+// Match returns true if actual is a number greater than the provided number
+func (m *greaterThan) Match(value interface{}) bool {
+	expected, ok := numericValue(m.value)
+	if !ok {
+		return false
+	}
+
+	actual, ok := numericValue(value)
+	if !ok {
+		return false
+	}
+
+	c, ok := actual.compare(expected)
+	return ok && c > 0
+}
+
+// String returns a description of what the greater than matcher expects
+func (m *greaterThan) String() string {
+	return fmt.Sprintf("number greater than %v", formatValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the greater than matcher
+func (m *greaterThan) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,67 @@
+package match
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("greaterThan", func() {
+	Describe("GreaterThan", func() {
+		It("returns a greaterThan struct", func() {
+			actual := GreaterThan(10)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(greaterThan)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the numeric kinds", func() {
+			actual := GreaterThan(5).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(numericKinds()))
+		})
+
+		It("returns no kinds when the value is not a number", func() {
+			actual := GreaterThan("5").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(GreaterThan(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("with int", 5, int(10)),
+		Entry("with int8", 5, int8(10)),
+		Entry("with uint64", 5, uint64(10)),
+		Entry("with float32", 5, float32(5.5)),
+		Entry("with float64", 5.5, float64(5.6)),
+		Entry("with a defined int type", 5, namedInt(10)),
+		Entry("with a defined uint type", 5, namedUint(10)),
+		Entry("with a defined float type", 5, namedFloat(10)),
+		Entry("with a uint greater than the max int64", int64(math.MaxInt64), uint64(math.MaxUint64)),
+		Entry("with a uint greater than a negative int", -1, uint(0)),
+		Entry("with an int greater than a float", 5.5, 6),
+		Entry("with positive infinity", math.MaxFloat64, math.Inf(1)),
+		Entry("with an int greater than a float32 it would round to", float32(16777216), 16777217),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(GreaterThan(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 5, nil),
+		Entry("when actual is not a number", 5, "10"),
+		Entry("when the value is not a number", "5", 10),
+		Entry("when actual is less than expected", 5, 1),
+		Entry("when actual is the same as expected", 5, uint8(5)),
+		Entry("when actual is a negative int less than a uint", uint(0), -1),
+		Entry("when actual(float32) is the same as expected converted to float32", 0.1, float32(0.1)),
+		Entry("when actual is NaN", 5, math.NaN()),
+		Entry("when the value is NaN", math.NaN(), 5),
+	)
+})
@@ -0,0 +1,276 @@
+package match
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// HTTPRequest returns a new matcher for *http.Request values that matches any
+// request until it is narrowed down by chaining the builder methods, such as:
+//
+//	match.HTTPRequest().Method("POST").Path("/v1/items/*").Body(match.JSONEq(`{"id": 7}`))
+func HTTPRequest() *HTTPRequestMatcher {
+	return &HTTPRequestMatcher{}
+}
+
+// HTTPRequestMatcher is a matcher for *http.Request values built using HTTPRequest
+type HTTPRequestMatcher struct {
+	method      *string
+	path        *string
+	headers     []httpRequestValue
+	queries     []httpRequestValue
+	body        interface{}
+	matchesBody bool
+}
+
+// httpRequestValue is a header or query parameter name and the value to match against
+type httpRequestValue struct {
+	name  string
+	value interface{}
+}
+
+// Method narrows the matcher to requests with the provided method.
+// Requests with an empty method are treated as GET requests.
+func (m *HTTPRequestMatcher) Method(method string) *HTTPRequestMatcher {
+	m.method = &method
+	return m
+}
+
+// Path narrows the matcher to requests with a URL path that matches the provided
+// pattern using path.Match, where "*" matches a single segment of the path
+func (m *HTTPRequestMatcher) Path(pattern string) *HTTPRequestMatcher {
+	m.path = &pattern
+	return m
+}
+
+// Header narrows the matcher to requests where one of the values of the header
+// matches the provided value, which can be a matcher or a value that is compared
+// using reflect.DeepEqual. It can be called more than once to match several headers.
+func (m *HTTPRequestMatcher) Header(name string, value interface{}) *HTTPRequestMatcher {
+	m.headers = append(m.headers, httpRequestValue{http.CanonicalHeaderKey(name), value})
+	return m
+}
+
+// Query narrows the matcher to requests where one of the values of the query
+// parameter matches the provided value, which can be a matcher or a value that
+// is compared using reflect.DeepEqual. It can be called more than once to match
+// several query parameters.
+func (m *HTTPRequestMatcher) Query(name string, value interface{}) *HTTPRequestMatcher {
+	m.queries = append(m.queries, httpRequestValue{name, value})
+	return m
+}
+
+// Body narrows the matcher to requests with a body, as a string, that matches
+// the provided value, which can be a matcher or a value that is compared using
+// reflect.DeepEqual. The body is not consumed, so the code under test can still
+// read it; a body that cannot be read again using GetBody is buffered and the
+// request body is replaced with one that reads from the buffer.
+func (m *HTTPRequestMatcher) Body(value interface{}) *HTTPRequestMatcher {
+	m.body = value
+	m.matchesBody = true
+	return m
+}
+
+// SupportedKinds returns all the kinds the HTTP request matcher supports;
+// no kinds are supported if the path pattern is malformed
+func (m *HTTPRequestMatcher) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.path != nil {
+		if _, err := path.Match(*m.path, ""); err != nil {
+			return map[reflect.Kind]struct{}{}
+		}
+	}
+
+	return map[reflect.Kind]struct{}{
+		reflect.Ptr: {},
+	}
+}
+
+// Match returns true if actual is a non-nil *http.Request that
+// matches everything the matcher was narrowed to; otherwise false
+func (m *HTTPRequestMatcher) Match(value interface{}) bool {
+	r, ok := value.(*http.Request)
+	if !ok || r == nil {
+		return false
+	}
+
+	if m.method != nil && *m.method != requestMethod(r) {
+		return false
+	}
+
+	if m.path != nil {
+		if r.URL == nil {
+			return false
+		}
+
+		if matched, err := path.Match(*m.path, r.URL.Path); err != nil || !matched {
+			return false
+		}
+	}
+
+	for _, h := range m.headers {
+		if !matchesAnyValue(h.value, r.Header[h.name]) {
+			return false
+		}
+	}
+
+	if len(m.queries) > 0 {
+		if r.URL == nil {
+			return false
+		}
+
+		query := r.URL.Query()
+		for _, q := range m.queries {
+			if !matchesAnyValue(q.value, query[q.name]) {
+				return false
+			}
+		}
+	}
+
+	if m.matchesBody {
+		body, ok := requestBody(r)
+		if !ok {
+			return false
+		}
+
+		return matchesValue(m.body, reflect.ValueOf(body))
+	}
+
+	return true
+}
+
+// String returns a description of what the HTTP request matcher expects
+func (m *HTTPRequestMatcher) String() string {
+	var parts []string
+	if m.method != nil {
+		parts = append(parts, fmt.Sprintf("method %q", *m.method))
+	}
+
+	if m.path != nil {
+		parts = append(parts, fmt.Sprintf("path matching %q", *m.path))
+	}
+
+	for _, h := range m.headers {
+		parts = append(parts, fmt.Sprintf("header %q matching %v", h.name, describeValue(h.value)))
+	}
+
+	for _, q := range m.queries {
+		parts = append(parts, fmt.Sprintf("query %q matching %v", q.name, describeValue(q.value)))
+	}
+
+	if m.matchesBody {
+		parts = append(parts, fmt.Sprintf("body matching %v", describeValue(m.body)))
+	}
+
+	if len(parts) == 0 {
+		return "*http.Request"
+	}
+
+	return "*http.Request with " + strings.Join(parts, ", ")
+}
+
+// Explain returns an explanation of the first part of the
+// request that does not match the HTTP request matcher
+func (m *HTTPRequestMatcher) Explain(value interface{}) string {
+	r, ok := value.(*http.Request)
+	if !ok || r == nil {
+		return explain(m, value)
+	}
+
+	if m.method != nil && *m.method != requestMethod(r) {
+		return fmt.Sprintf("expected method %q, got %q", *m.method, requestMethod(r))
+	}
+
+	if m.path != nil {
+		if r.URL == nil {
+			return fmt.Sprintf("expected path matching %q, got a request without a URL", *m.path)
+		}
+
+		if matched, err := path.Match(*m.path, r.URL.Path); err != nil || !matched {
+			return fmt.Sprintf("expected path matching %q, got %q", *m.path, r.URL.Path)
+		}
+	}
+
+	for _, h := range m.headers {
+		if !matchesAnyValue(h.value, r.Header[h.name]) {
+			return fmt.Sprintf("expected header %q matching %v, got %v", h.name, describeValue(h.value), formatValue(r.Header[h.name]))
+		}
+	}
+
+	if len(m.queries) > 0 && r.URL != nil {
+		query := r.URL.Query()
+		for _, q := range m.queries {
+			if !matchesAnyValue(q.value, query[q.name]) {
+				return fmt.Sprintf("expected query %q matching %v, got %v", q.name, describeValue(q.value), formatValue(query[q.name]))
+			}
+		}
+	}
+
+	if m.matchesBody {
+		body, ok := requestBody(r)
+		if !ok {
+			return fmt.Sprintf("expected body matching %v, but the body could not be read", describeValue(m.body))
+		}
+
+		if !matchesValue(m.body, reflect.ValueOf(body)) {
+			return fmt.Sprintf("body: %v", explainValue(m.body, reflect.ValueOf(body)))
+		}
+	}
+
+	return explain(m, value)
+}
+
+// requestMethod returns the method of the request, which is GET when it is empty
+func requestMethod(r *http.Request) string {
+	if r.Method == "" {
+		return http.MethodGet
+	}
+
+	return r.Method
+}
+
+// matchesAnyValue returns true if any of the values matches the expected value
+func matchesAnyValue(expected interface{}, values []string) bool {
+	for _, v := range values {
+		if matchesValue(expected, reflect.ValueOf(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requestBody returns the body of the request without consuming it and true
+// if it could be read; otherwise false. Bodies that cannot be read again using
+// GetBody are buffered and replaced with a body that reads from the buffer.
+func requestBody(r *http.Request) (string, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", true
+	}
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return "", false
+		}
+		defer body.Close()
+
+		content, err := io.ReadAll(body)
+		return string(content), err == nil
+	}
+
+	content, err := io.ReadAll(r.Body)
+	r.Body = &replayBody{io.MultiReader(bytes.NewReader(content), r.Body), r.Body}
+	return string(content), err == nil
+}
+
+// replayBody is a request body that replays the content that was read
+// while matching before reading the rest of the original body
+type replayBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,176 @@
+package match
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("httpRequest", func() {
+	newRequest := func(method string, target string, body io.Reader) *http.Request {
+		r, err := http.NewRequest(method, target, body)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		r.Header.Set("Authorization", "Bearer token")
+		r.Header.Add("Accept", "text/plain")
+		r.Header.Add("Accept", "application/json")
+		return r
+	}
+
+	Describe("HTTPRequest", func() {
+		It("returns an HTTPRequestMatcher", func() {
+			actual := HTTPRequest()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(HTTPRequestMatcher)))
+		})
+
+		It("returns the same matcher from the builder methods", func() {
+			m := HTTPRequest()
+
+			gomega.Expect(m.Method("GET").Path("/").Header("a", "b").Query("c", "d").Body("e")).To(gomega.BeIdenticalTo(m))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the pointer kind", func() {
+			gomega.Expect(HTTPRequest().SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Ptr: {},
+				}))
+		})
+
+		It("returns no kinds when the path pattern is malformed", func() {
+			gomega.Expect(HTTPRequest().Path("/v1/[").SupportedKinds()).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher *HTTPRequestMatcher, actual func() *http.Request) {
+			gomega.Expect(matcher.Match(actual())).To(gomega.BeTrue())
+		},
+		Entry("when the matcher has not been narrowed", HTTPRequest(), func() *http.Request {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when the method is equal", HTTPRequest().Method("POST"), func() *http.Request {
+			return newRequest("POST", "http://localhost/", nil)
+		}),
+		Entry("when the method is empty and GET is expected", HTTPRequest().Method("GET"), func() *http.Request {
+			return &http.Request{URL: &url.URL{Path: "/"}}
+		}),
+		Entry("when the path is equal", HTTPRequest().Path("/v1/items"), func() *http.Request {
+			return newRequest("GET", "http://localhost/v1/items?page=2", nil)
+		}),
+		Entry("when the path matches the pattern", HTTPRequest().Path("/v1/items/*"), func() *http.Request {
+			return newRequest("GET", "http://localhost/v1/items/42", nil)
+		}),
+		Entry("when the header matches", HTTPRequest().Header("authorization", StringPrefix("Bearer ")), func() *http.Request {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when one of the header values is equal", HTTPRequest().Header("Accept", "application/json"), func() *http.Request {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when the query parameters match", HTTPRequest().Query("page", "2").Query("sort", OneOf("asc", "desc")), func() *http.Request {
+			return newRequest("GET", "http://localhost/?page=2&sort=desc", nil)
+		}),
+		Entry("when the body is equal", HTTPRequest().Body("mocka"), func() *http.Request {
+			return newRequest("POST", "http://localhost/", strings.NewReader("mocka"))
+		}),
+		Entry("when the body matches", HTTPRequest().Body(JSONEq(`{"id":7}`)), func() *http.Request {
+			return newRequest("POST", "http://localhost/", strings.NewReader(`{ "id": 7 }`))
+		}),
+		Entry("when the body cannot be read again", HTTPRequest().Body("mocka"), func() *http.Request {
+			r := newRequest("POST", "http://localhost/", io.NopCloser(strings.NewReader("mocka")))
+			r.GetBody = nil
+			return r
+		}),
+		Entry("when there is no body and an empty body is expected", HTTPRequest().Body(Empty()), func() *http.Request {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when everything matches", HTTPRequest().
+			Method("POST").
+			Path("/v1/items/*").
+			Header("Authorization", StringPrefix("Bearer ")).
+			Query("page", "2").
+			Body(JSONPath("name", "mocka")), func() *http.Request {
+			return newRequest("POST", "http://localhost/v1/items/42?page=2", strings.NewReader(`{"name":"mocka"}`))
+		}),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher *HTTPRequestMatcher, actual func() interface{}) {
+			gomega.Expect(matcher.Match(actual())).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", HTTPRequest(), func() interface{} { return nil }),
+		Entry("when actual is a nil request", HTTPRequest(), func() interface{} { return (*http.Request)(nil) }),
+		Entry("when actual is not a request", HTTPRequest(), func() interface{} { return &http.Response{} }),
+		Entry("when the method is different", HTTPRequest().Method("POST"), func() interface{} {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when the path is different", HTTPRequest().Path("/v1/items/*"), func() interface{} {
+			return newRequest("GET", "http://localhost/v1/items/42/parts", nil)
+		}),
+		Entry("when the path pattern is malformed", HTTPRequest().Path("/v1/["), func() interface{} {
+			return newRequest("GET", "http://localhost/v1/[", nil)
+		}),
+		Entry("when the request has no URL", HTTPRequest().Path("/"), func() interface{} {
+			return &http.Request{}
+		}),
+		Entry("when the request has no URL and a query is expected", HTTPRequest().Query("page", "2"), func() interface{} {
+			return &http.Request{}
+		}),
+		Entry("when the header is missing", HTTPRequest().Header("X-Request-Id", Anything()), func() interface{} {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when no header value matches", HTTPRequest().Header("Accept", "text/html"), func() interface{} {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when one of the headers does not match", HTTPRequest().Header("Accept", "text/plain").Header("Authorization", "Basic"), func() interface{} {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when the query parameter is missing", HTTPRequest().Query("page", "2"), func() interface{} {
+			return newRequest("GET", "http://localhost/", nil)
+		}),
+		Entry("when the query parameter is different", HTTPRequest().Query("page", "2"), func() interface{} {
+			return newRequest("GET", "http://localhost/?page=3", nil)
+		}),
+		Entry("when the body is different", HTTPRequest().Body("mocka"), func() interface{} {
+			return newRequest("POST", "http://localhost/", strings.NewReader("gomock"))
+		}),
+		Entry("when the body cannot be read", HTTPRequest().Body(Anything()), func() interface{} {
+			r := newRequest("POST", "http://localhost/", strings.NewReader("mocka"))
+			r.GetBody = func() (io.ReadCloser, error) { return nil, errors.New("closed") }
+			return r
+		}),
+	)
+
+	Describe("Body", func() {
+		It("does not consume a body that can be read again", func() {
+			r := newRequest("POST", "http://localhost/", strings.NewReader("mocka"))
+
+			gomega.Expect(HTTPRequest().Body("mocka").Match(r)).To(gomega.BeTrue())
+
+			content, err := io.ReadAll(r.Body)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(string(content)).To(gomega.Equal("mocka"))
+		})
+
+		It("replays a body that cannot be read again", func() {
+			r := newRequest("POST", "http://localhost/", io.NopCloser(strings.NewReader("mocka")))
+			r.GetBody = nil
+
+			gomega.Expect(HTTPRequest().Body("mocka").Match(r)).To(gomega.BeTrue())
+			gomega.Expect(HTTPRequest().Body("mocka").Match(r)).To(gomega.BeTrue())
+
+			content, err := io.ReadAll(r.Body)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(string(content)).To(gomega.Equal("mocka"))
+			gomega.Expect(r.Body.Close()).To(gomega.Succeed())
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -47,3 +48,13 @@ func (m *implementerOf) Match(value interface{}) bool {
 
 	return actualType.Implements(expectedType.Elem())
 }
+
+// String returns a description of what the implementer of matcher expects
+func (m *implementerOf) String() string {
+	return fmt.Sprintf("implementer of %v", describeType(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the implementer of matcher
+func (m *implementerOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
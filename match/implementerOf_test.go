@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("implementerOf", func() {
@@ -13,7 +13,7 @@ var _ = Describe("implementerOf", func() {
 		It("returns an implementerOf struct", func() {
 			actual := ImplementerOf(new(SupportedKindsMatcher))
 
-			Expect(actual).To(BeAssignableToTypeOf(new(implementerOf)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(implementerOf)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("implementerOf", func() {
 		It("returns all support kinds in go", func() {
 			actual := ImplementerOf(new(SupportedKindsMatcher)).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Ptr: {},
 				}))
@@ -30,13 +30,13 @@ var _ = Describe("implementerOf", func() {
 
 	Describe("Match", func() {
 		It("returns true when the struct implements the provided interface", func() {
-			Expect(ImplementerOf(new(SupportedKindsMatcher)).Match(&implementerOf{})).To(BeTrue())
+			gomega.Expect(ImplementerOf(new(SupportedKindsMatcher)).Match(&implementerOf{})).To(gomega.BeTrue())
 		})
 	})
 
 	DescribeTable("Match returns false",
 		func(i interface{}, value interface{}) {
-			Expect(ImplementerOf(i).Match(value)).To(BeFalse())
+			gomega.Expect(ImplementerOf(i).Match(value)).To(gomega.BeFalse())
 		},
 		Entry("when interface is not valid", (SupportedKindsMatcher)(nil), &implementerOf{}),
 		Entry("when value is not valid", new(SupportedKindsMatcher), (SupportedKindsMatcher)(nil)),
@@ -0,0 +1,45 @@
+package match
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// InDelta returns a new matcher that will match numbers within the provided
+// delta of the provided value. Any int, uint or float kind can be matched,
+// including defined types.
+func InDelta(value float64, delta float64) SupportedKindsMatcher {
+	return &inDelta{value, delta}
+}
+
+type inDelta struct {
+	value float64
+	delta float64
+}
+
+// SupportedKinds returns all the kinds the in delta matcher supports
+func (inDelta) SupportedKinds() map[reflect.Kind]struct{} {
+	return numericKinds()
+}
+
+// Match returns true if the difference between actual and the provided
+// value is less than or equal to the provided delta; otherwise false
+func (m *inDelta) Match(value interface{}) bool {
+	actual, ok := numericValue(value)
+	if !ok {
+		return false
+	}
+
+	return math.Abs(actual.float()-m.value) <= m.delta
+}
+
+// String returns a description of what the in delta matcher expects
+func (m *inDelta) String() string {
+	return fmt.Sprintf("number within %v of %v", m.delta, m.value)
+}
+
+// Explain returns an explanation of why the value does not match the in delta matcher
+func (m *inDelta) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,52 @@
+package match
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("inDelta", func() {
+	Describe("InDelta", func() {
+		It("returns an inDelta struct", func() {
+			actual := InDelta(1, 0.1)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(inDelta)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the numeric kinds", func() {
+			actual := InDelta(1, 0.1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(numericKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(value float64, delta float64, actual interface{}) {
+			gomega.Expect(InDelta(value, delta).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is the value", 1.5, 0.1, 1.5),
+		Entry("when actual is less than the value within the delta", 0.3, 0.001, 0.1+0.2),
+		Entry("when actual is greater than the value within the delta", 1.5, 0.1, 1.55),
+		Entry("when actual is a float32", 0.1, 0.0001, float32(0.1)),
+		Entry("when actual is an int", 2.0, 0.5, 2),
+		Entry("when actual is a uint", 2.0, 0.5, uint(2)),
+		Entry("with a defined float type", 2.0, 0.5, namedFloat(2.25)),
+	)
+
+	DescribeTable("Match returns false",
+		func(value float64, delta float64, actual interface{}) {
+			gomega.Expect(InDelta(value, delta).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1.5, 0.1, nil),
+		Entry("when actual is not a number", 1.5, 0.1, "1.5"),
+		Entry("when actual is outside the delta", 1.5, 0.1, 1.7),
+		Entry("when actual is NaN", 1.5, 0.1, math.NaN()),
+		Entry("when actual is infinite", 1.5, 0.1, math.Inf(1)),
+		Entry("when the delta is negative", 1.5, -0.1, 1.5),
+	)
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -30,18 +31,20 @@ func (m *intGreaterThan) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
-	case reflect.Int:
-		return value.(int) > int(m.value)
-	case reflect.Int8:
-		return value.(int8) > int8(m.value)
-	case reflect.Int16:
-		return value.(int16) > int16(m.value)
-	case reflect.Int32:
-		return value.(int32) > int32(m.value)
-	case reflect.Int64:
-		return value.(int64) > m.value
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() > m.value
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the int greater than matcher expects
+func (m *intGreaterThan) String() string {
+	return fmt.Sprintf("int greater than %v", m.value)
+}
+
+// Explain returns an explanation of why the value does not match the int greater than matcher
+func (m *intGreaterThan) Explain(value interface{}) string {
+	return explain(m, value)
+}
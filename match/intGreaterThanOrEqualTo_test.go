@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("intGreaterThanOrEqualTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("intGreaterThanOrEqualTo", func() {
 		It("returns an intGreaterThanOrEqualTo struct", func() {
 			actual := IntGreaterThanOrEqualTo(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(intGreaterThanOrEqualTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(intGreaterThanOrEqualTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("intGreaterThanOrEqualTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := IntGreaterThanOrEqualTo(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Int:   {},
 					reflect.Int8:  {},
@@ -34,7 +34,7 @@ var _ = Describe("intGreaterThanOrEqualTo", func() {
 
 	DescribeTable("Match returns true",
 		func(expected int64, actual interface{}) {
-			Expect(IntGreaterThanOrEqualTo(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(IntGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with int", int64(5), int(10)),
 		Entry("with int8", int64(10), int8(18)),
@@ -46,11 +46,12 @@ var _ = Describe("intGreaterThanOrEqualTo", func() {
 		Entry("when actual(int16) is the same as the expected", int64(15), int16(15)),
 		Entry("when actual(int32) is the same as the expected", int64(20), int32(20)),
 		Entry("when actual(int64) is the same as the expected", int64(8), int64(8)),
+		Entry("with a defined int type", int64(5), namedInt(5)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected int64, actual interface{}) {
-			Expect(IntGreaterThanOrEqualTo(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(IntGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", int64(5), nil),
 		Entry("when actual(int) is less than expected", int64(5), int(1)),
@@ -59,5 +60,7 @@ var _ = Describe("intGreaterThanOrEqualTo", func() {
 		Entry("when actual(int32) is less than expected", int64(20), int32(4)),
 		Entry("when actual(int64) is less than expected", int64(8), int64(5)),
 		Entry("when actual is not an int", int64(10), "10"),
+		Entry("when actual(defined int type) is less than expected", int64(5), namedInt(1)),
+		Entry("when expected is out of the range of int8", int64(300), int8(50)),
 	)
 })
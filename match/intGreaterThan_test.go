@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("intGreaterThan", func() {
@@ -13,7 +13,7 @@ var _ = Describe("intGreaterThan", func() {
 		It("returns an intGreaterThan struct", func() {
 			actual := IntGreaterThan(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(intGreaterThan)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(intGreaterThan)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("intGreaterThan", func() {
 		It("returns all support kinds in go", func() {
 			actual := IntGreaterThan(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Int:   {},
 					reflect.Int8:  {},
@@ -34,18 +34,19 @@ var _ = Describe("intGreaterThan", func() {
 
 	DescribeTable("Match returns true",
 		func(expected int64, actual interface{}) {
-			Expect(IntGreaterThan(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(IntGreaterThan(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with int", int64(5), int(10)),
 		Entry("with int8", int64(10), int8(18)),
 		Entry("with int16", int64(15), int16(22)),
 		Entry("with int32", int64(20), int32(40)),
 		Entry("with int64", int64(8), int64(15)),
+		Entry("with a defined int type", int64(5), namedInt(10)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected int64, actual interface{}) {
-			Expect(IntGreaterThan(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(IntGreaterThan(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", int64(5), nil),
 		Entry("when actual(int) is less than expected", int64(5), int(1)),
@@ -59,5 +60,7 @@ var _ = Describe("intGreaterThan", func() {
 		Entry("when actual(int32) is the same as the expected", int64(20), int32(20)),
 		Entry("when actual(int64) is the same as the expected", int64(8), int64(8)),
 		Entry("when actual is not an int", int64(10), "10"),
+		Entry("when actual(defined int type) is less than expected", int64(5), namedInt(1)),
+		Entry("when expected is out of the range of int8", int64(300), int8(50)),
 	)
 })
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("intLessThanOrEqualTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 		It("returns an intLessThanOrEqualTo struct", func() {
 			actual := IntLessThanOrEqualTo(10)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(intLessThanOrEqualTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(intLessThanOrEqualTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := IntLessThanOrEqualTo(5).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Int:   {},
 					reflect.Int8:  {},
@@ -34,7 +34,7 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 
 	DescribeTable("Match returns true",
 		func(expected int64, actual interface{}) {
-			Expect(IntLessThanOrEqualTo(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(IntLessThanOrEqualTo(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with int", int64(10), int(5)),
 		Entry("with int8", int64(18), int8(10)),
@@ -46,11 +46,13 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 		Entry("when actual(int16) is the same as the expected", int64(15), int16(15)),
 		Entry("when actual(int32) is the same as the expected", int64(20), int32(20)),
 		Entry("when actual(int64) is the same as the expected", int64(8), int64(8)),
+		Entry("with a defined int type", int64(5), namedInt(5)),
+		Entry("when expected is out of the range of int8", int64(300), int8(50)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected int64, actual interface{}) {
-			Expect(IntLessThanOrEqualTo(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(IntLessThanOrEqualTo(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", int64(5), nil),
 		Entry("when actual(int) is greater than expected", int64(1), int(5)),
@@ -59,5 +61,6 @@ var _ = Describe("intLessThanOrEqualTo", func() {
 		Entry("when actual(int32) is greater than expected", int64(4), int32(20)),
 		Entry("when actual(int64) is greater than expected", int64(5), int64(8)),
 		Entry("when actual is not an int", int64(10), "10"),
+		Entry("when actual(defined int type) is greater than expected", int64(5), namedInt(10)),
 	)
 })
@@ -0,0 +1,66 @@
+package match
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// JSONEq returns a new matcher that will match strings and byte slices containing
+// JSON that is semantically equal to the expected JSON, ignoring whitespace and the
+// order of object keys. The expected value can be JSON text as a string or byte
+// slice, or any other value which is encoded with json.Marshal. Numbers are
+// compared exactly, so 1 and 1.0 are equal but large integers that round to
+// the same float64 are not.
+func JSONEq(expected interface{}) SupportedKindsMatcher {
+	if _, ok := textValue(expected); !ok {
+		if b, err := json.Marshal(expected); err == nil {
+			expected = b
+		}
+	}
+
+	decoded, ok := jsonValue(expected)
+	return &jsonEq{decoded, ok}
+}
+
+type jsonEq struct {
+	expected interface{}
+	valid    bool
+}
+
+// SupportedKinds returns all the kinds the JSON equal matcher supports;
+// no kinds are supported if the expected value is not valid JSON
+func (m *jsonEq) SupportedKinds() map[reflect.Kind]struct{} {
+	if !m.valid {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return textKinds()
+}
+
+// Match returns true if actual is valid JSON that is equal to the expected JSON; otherwise false
+func (m *jsonEq) Match(value interface{}) bool {
+	actual, ok := jsonValue(value)
+	return ok && m.valid && jsonEqual(m.expected, actual)
+}
+
+// String returns a description of what the JSON equal matcher expects
+func (m *jsonEq) String() string {
+	if !m.valid {
+		return "JSON equal to invalid JSON"
+	}
+
+	expected, _ := json.Marshal(m.expected)
+	return fmt.Sprintf("JSON equal to %s", expected)
+}
+
+// Explain returns an explanation of why the value does not match the JSON equal matcher
+func (m *jsonEq) Explain(value interface{}) string {
+	if _, ok := jsonValue(value); !ok {
+		if _, ok := textValue(value); ok {
+			return fmt.Sprintf("expected %v, got invalid JSON %v", m, formatValue(value))
+		}
+	}
+
+	return explain(m, value)
+}
@@ -0,0 +1,65 @@
+package match
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("jsonEq", func() {
+	Describe("JSONEq", func() {
+		It("returns a jsonEq struct", func() {
+			actual := JSONEq(`{"a":1}`)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(jsonEq)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the text kinds", func() {
+			gomega.Expect(JSONEq(`{"a":1}`).SupportedKinds()).To(gomega.Equal(textKinds()))
+		})
+
+		DescribeTable("returns no kinds when the expected value is not valid JSON",
+			func(expected interface{}) {
+				gomega.Expect(JSONEq(expected).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("for invalid JSON text", `{"a":`),
+			Entry("for a value that cannot be encoded", make(chan int)),
+		)
+	})
+
+	DescribeTable("Match returns true",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(JSONEq(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the JSON is identical", `{"a":1}`, `{"a":1}`),
+		Entry("when the JSON differs by whitespace", `{"a":1,"b":[1,2]}`, "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}"),
+		Entry("when the object keys are in a different order", `{"a":1,"b":2}`, `{"b":2,"a":1}`),
+		Entry("when actual is a byte slice", `{"a":1}`, []byte(`{"a":1}`)),
+		Entry("when actual is a named string", `"mocka"`, namedString(`"mocka"`)),
+		Entry("when the expected value is a byte slice", []byte(`[1,2]`), `[1,2]`),
+		Entry("when the expected value is encoded", map[string]interface{}{"a": 1}, `{"a":1.0}`),
+		Entry("when a number is written with an exponent", `{"a":100}`, `{"a":1e2}`),
+		Entry("when a large integer is equal", `{"id":12345678901234567}`, `{"id":12345678901234567}`),
+		Entry("when the expected value is a struct", struct {
+			Name string `json:"name"`
+		}{"mocka"}, `{"name":"mocka"}`),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(JSONEq(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", `{"a":1}`, nil),
+		Entry("when actual is not text", `1`, 1),
+		Entry("when actual is not valid JSON", `{"a":1}`, `{"a":1`),
+		Entry("when a value is different", `{"a":1}`, `{"a":2}`),
+		Entry("when a large integer differs beyond the precision of a float64", `{"id":12345678901234567}`, `{"id":12345678901234568}`),
+		Entry("when a number is compared to a string", `{"a":1}`, `{"a":"1"}`),
+		Entry("when actual has data after the JSON", `{"a":1}`, `{"a":1} {"a":1}`),
+		Entry("when actual has an extra key", `{"a":1}`, `{"a":1,"b":2}`),
+		Entry("when the array order is different", `[1,2]`, `[2,1]`),
+		Entry("when the expected value is not valid JSON", `{"a":`, `{"a":1}`),
+	)
+})
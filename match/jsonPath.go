@@ -0,0 +1,107 @@
+package match
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONPath returns a new matcher that will match strings and byte slices containing
+// JSON when the value at the provided dotted path matches the provided value, which
+// can be a matcher or a value that is compared using reflect.DeepEqual. Array elements
+// are selected by their index, for example "items.0.id". An expected int, uint or
+// float value is compared numerically with the JSON number, with integers compared
+// exactly; otherwise JSON numbers are decoded as float64 like json.Unmarshal does.
+func JSONPath(path string, value interface{}) SupportedKindsMatcher {
+	return &jsonPath{strings.Split(path, "."), value}
+}
+
+type jsonPath struct {
+	path  []string
+	value interface{}
+}
+
+// SupportedKinds returns all the kinds the JSON path matcher supports
+func (jsonPath) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match returns true if actual is valid JSON and the value
+// at the path matches the provided value; otherwise false
+func (m *jsonPath) Match(value interface{}) bool {
+	decoded, ok := jsonValue(value)
+	if !ok {
+		return false
+	}
+
+	for _, segment := range m.path {
+		if decoded, ok = jsonIndex(decoded, segment); !ok {
+			return false
+		}
+	}
+
+	if expected, ok := numericValue(m.value); ok {
+		n, ok := decoded.(json.Number)
+		if !ok {
+			return false
+		}
+
+		actual, ok := jsonNumber(n)
+		if !ok {
+			return false
+		}
+
+		c, ok := actual.compare(expected)
+		return ok && c == 0
+	}
+
+	decoded = jsonFloats(decoded)
+	return matchesValue(m.value, reflect.ValueOf(&decoded).Elem())
+}
+
+// String returns a description of what the JSON path matcher expects
+func (m *jsonPath) String() string {
+	return fmt.Sprintf("JSON with %q matching %v", strings.Join(m.path, "."), describeValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the JSON path matcher
+func (m *jsonPath) Explain(value interface{}) string {
+	decoded, ok := jsonValue(value)
+	if !ok {
+		if _, ok := textValue(value); ok {
+			return fmt.Sprintf("expected %v, got invalid JSON %v", m, formatValue(value))
+		}
+
+		return explain(m, value)
+	}
+
+	for _, segment := range m.path {
+		if decoded, ok = jsonIndex(decoded, segment); !ok {
+			return fmt.Sprintf("expected %v, but %q does not exist in %v", m, strings.Join(m.path, "."), formatValue(value))
+		}
+	}
+
+	decoded = jsonFloats(decoded)
+	return fmt.Sprintf("%q: %v", strings.Join(m.path, "."), explainValue(m.value, reflect.ValueOf(&decoded).Elem()))
+}
+
+// jsonIndex returns the value of the object key or array index and true
+// if it exists in the decoded JSON value; otherwise false
+func jsonIndex(decoded interface{}, segment string) (interface{}, bool) {
+	switch d := decoded.(type) {
+	case map[string]interface{}:
+		v, ok := d[segment]
+		return v, ok
+	case []interface{}:
+		i, err := strconv.Atoi(segment)
+		if err != nil || i < 0 || i >= len(d) {
+			return nil, false
+		}
+
+		return d[i], true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,64 @@
+package match
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("jsonPath", func() {
+	const document = `{"id":7,"serial":12345678901234567,"name":"mocka","tags":["go","test"],"owner":{"name":"Bayer","active":true},"parent":null}`
+
+	Describe("JSONPath", func() {
+		It("returns a jsonPath struct", func() {
+			actual := JSONPath("id", 7)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(jsonPath)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the text kinds", func() {
+			gomega.Expect(JSONPath("id", 7).SupportedKinds()).To(gomega.Equal(textKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(path string, value interface{}, actual interface{}) {
+			gomega.Expect(JSONPath(path, value).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when a number equals an int", "id", 7, document),
+		Entry("when a number equals a float", "id", 7.0, document),
+		Entry("when a large integer is equal", "serial", 12345678901234567, document),
+		Entry("when a number matches a matcher", "id", Between(1, 10), document),
+		Entry("when a string is equal", "name", "mocka", document),
+		Entry("when a string matches a matcher", "name", StringPrefix("mo"), document),
+		Entry("when a nested value is equal", "owner.active", true, document),
+		Entry("when an array element is equal", "tags.1", "test", document),
+		Entry("when an array matches a matcher", "tags", ConsistsOf("test", "go"), document),
+		Entry("when an object contains the entries", "owner", MapContaining("name", "Bayer"), document),
+		Entry("when an object is equal", "owner", map[string]interface{}{"name": "Bayer", "active": true}, document),
+		Entry("when a null value is nil", "parent", nil, document),
+		Entry("when actual is a byte slice", "name", "mocka", []byte(document)),
+	)
+
+	DescribeTable("Match returns false",
+		func(path string, value interface{}, actual interface{}) {
+			gomega.Expect(JSONPath(path, value).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", "id", 7, nil),
+		Entry("when actual is not text", "id", 7, 7),
+		Entry("when actual is not valid JSON", "id", 7, `{"id":7`),
+		Entry("when the key does not exist", "missing", nil, document),
+		Entry("when the path goes through a string", "name.first", "mocka", document),
+		Entry("when the array index is not a number", "tags.first", "go", document),
+		Entry("when the array index is out of range", "tags.2", "go", document),
+		Entry("when the array index is negative", "tags.-1", "test", document),
+		Entry("when a number is different", "id", 8, document),
+		Entry("when a large integer differs beyond the precision of a float64", "serial", 12345678901234568, document),
+		Entry("when a number is compared to a string", "name", 7, document),
+		Entry("when a string is different", "name", "gomock", document),
+		Entry("when the matcher does not support the kind", "id", StringPrefix("7"), document),
+		Entry("when an object only contains the entries", "owner", map[string]interface{}{"name": "Bayer"}, document),
+	)
+})
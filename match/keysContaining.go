@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -54,3 +55,13 @@ func (m *keysContaining) Match(value interface{}) bool {
 		return false
 	}
 }
+
+// String returns a description of what the keys containing matcher expects
+func (m *keysContaining) String() string {
+	return fmt.Sprintf("map with keys containing (%v)", describeValues(m.keys))
+}
+
+// Explain returns an explanation of why the value does not match the keys containing matcher
+func (m *keysContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
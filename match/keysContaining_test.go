@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("keysContaining", func() {
@@ -13,7 +13,7 @@ var _ = Describe("keysContaining", func() {
 		It("returns an keysContaining struct", func() {
 			actual := KeysContaining(2)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(keysContaining)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(keysContaining)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("keysContaining", func() {
 		It("returns all support kinds in go", func() {
 			actual := KeysContaining(3).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Map: {},
 				}))
@@ -30,7 +30,7 @@ var _ = Describe("keysContaining", func() {
 
 	DescribeTable("Match returns true",
 		func(keys []interface{}, actual interface{}) {
-			Expect(KeysContaining(keys...).Match(actual)).To(BeTrue())
+			gomega.Expect(KeysContaining(keys...).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when the keys and map are empty", []interface{}{}, map[string]string{}),
 		Entry("when all keys exist in the map", []interface{}{1, 2, 3}, map[int]string{
@@ -44,7 +44,7 @@ var _ = Describe("keysContaining", func() {
 
 	DescribeTable("Match returns false",
 		func(keys []interface{}, actual interface{}) {
-			Expect(KeysContaining(keys...).Match(actual)).To(BeFalse())
+			gomega.Expect(KeysContaining(keys...).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", []interface{}{1}, nil),
 		Entry("when actual is not a valid kind", []interface{}{"1"}, 123),
@@ -0,0 +1,71 @@
+package match
+
+import "reflect"
+
+// allKinds returns every kind in go
+func allKinds() map[reflect.Kind]struct{} {
+	return Anything().SupportedKinds()
+}
+
+// nillableKinds returns the kinds that can be nil
+func nillableKinds() map[reflect.Kind]struct{} {
+	return Nil().SupportedKinds()
+}
+
+// intersectKinds returns the kinds supported by every matcher
+func intersectKinds(matchers []SupportedKindsMatcher) map[reflect.Kind]struct{} {
+	kinds := allKinds()
+	for _, m := range matchers {
+		supported := m.SupportedKinds()
+		for k := range kinds {
+			if _, ok := supported[k]; !ok {
+				delete(kinds, k)
+			}
+		}
+	}
+
+	return kinds
+}
+
+// unionKinds returns the kinds supported by any of the matchers
+func unionKinds(matchers []SupportedKindsMatcher) map[reflect.Kind]struct{} {
+	kinds := map[reflect.Kind]struct{}{}
+	for _, m := range matchers {
+		for k := range m.SupportedKinds() {
+			kinds[k] = struct{}{}
+		}
+	}
+
+	return kinds
+}
+
+// assignableKinds returns the kinds of the values that can be assigned
+// to the type; all kinds can be assigned to an interface
+func assignableKinds(t reflect.Type) map[reflect.Kind]struct{} {
+	if t.Kind() == reflect.Interface {
+		return allKinds()
+	}
+
+	return map[reflect.Kind]struct{}{
+		t.Kind():          {},
+		reflect.Interface: {},
+	}
+}
+
+// assignableValue returns the value as a reflect.Value of the type and true
+// if it can be assigned to the type; otherwise false. A nil value is only
+// assignable to types that can be nil.
+func assignableValue(value interface{}, t reflect.Type) (reflect.Value, bool) {
+	v := reflect.New(t).Elem()
+	if value == nil {
+		return v, isNillable(t.Kind())
+	}
+
+	actual := reflect.ValueOf(value)
+	if !actual.Type().AssignableTo(t) {
+		return v, false
+	}
+
+	v.Set(actual)
+	return v, true
+}
@@ -1,6 +1,9 @@
 package match
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+)
 
 // LengthOf returns a new matcher that will match the length
 // of strings, slices, arrays, and maps
@@ -29,13 +32,26 @@ func (m *lengthOf) Match(value interface{}) bool {
 	}
 
 	switch v := reflect.ValueOf(value); v.Kind() {
-	case reflect.Array, reflect.Slice:
+	case reflect.Array, reflect.Slice, reflect.String:
 		return v.Len() == m.length
 	case reflect.Map:
 		return len(v.MapKeys()) == m.length
-	case reflect.String:
-		return len(value.(string)) == m.length
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the length of matcher expects
+func (m *lengthOf) String() string {
+	return fmt.Sprintf("value with length %v", m.length)
+}
+
+// Explain returns an explanation of why the value does not match the length of matcher
+func (m *lengthOf) Explain(value interface{}) string {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return fmt.Sprintf("expected %v, got %v with length %v", m, formatValue(value), v.Len())
+	default:
+		return explain(m, value)
+	}
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("lengthOf", func() {
@@ -13,7 +13,7 @@ var _ = Describe("lengthOf", func() {
 		It("returns an lengthOf struct", func() {
 			actual := LengthOf(2)
 
-			Expect(actual).To(BeAssignableToTypeOf(new(lengthOf)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(lengthOf)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("lengthOf", func() {
 		It("returns all support kinds in go", func() {
 			actual := LengthOf(3).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Array:  {},
 					reflect.Map:    {},
@@ -33,7 +33,7 @@ var _ = Describe("lengthOf", func() {
 
 	DescribeTable("Match returns true",
 		func(length int, actual interface{}) {
-			Expect(LengthOf(length).Match(actual)).To(BeTrue())
+			gomega.Expect(LengthOf(length).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when length matches for slice", 3, []int{1, 2, 3}),
 		Entry("when length matches for array", 2, [2]string{"a", "b"}),
@@ -43,11 +43,12 @@ var _ = Describe("lengthOf", func() {
 		Entry("with empty array", 0, [0]string{}),
 		Entry("with empty string", 0, ""),
 		Entry("with empty map", 0, map[int]string{}),
+		Entry("with a defined string type", 5, namedString("mocka")),
 	)
 
 	DescribeTable("Match returns false",
 		func(length int, actual interface{}) {
-			Expect(LengthOf(length).Match(actual)).To(BeFalse())
+			gomega.Expect(LengthOf(length).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", 0, nil),
 		Entry("when actual is not a valid kind", 1, 123),
@@ -55,5 +56,6 @@ var _ = Describe("lengthOf", func() {
 		Entry("when length does not matches for array", 1, [2]string{"a", "b"}),
 		Entry("when length does not matches for string", 8, "hello"),
 		Entry("when length does not matches for map", 2, map[int]string{0: "a"}),
+		Entry("with a defined string type of a different length", 4, namedString("mocka")),
 	)
 })
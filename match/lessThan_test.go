@@ -0,0 +1,62 @@
+package match
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("lessThan", func() {
+	Describe("LessThan", func() {
+		It("returns a lessThan struct", func() {
+			actual := LessThan(10)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(lessThan)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all the numeric kinds", func() {
+			actual := LessThan(5).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(numericKinds()))
+		})
+
+		It("returns no kinds when the value is not a number", func() {
+			actual := LessThan(nil).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(LessThan(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("with int", 5, int(1)),
+		Entry("with int16", 5, int16(-1)),
+		Entry("with uint32", 5, uint32(1)),
+		Entry("with float32", 5, float32(4.5)),
+		Entry("with float64", 5.5, float64(5.4)),
+		Entry("with a defined int type", 5, namedInt(1)),
+		Entry("with a defined uint type", 5, namedUint(1)),
+		Entry("with a defined float type", 5, namedFloat(1)),
+		Entry("with a negative int less than a uint", uint64(math.MaxUint64), int64(-1)),
+		Entry("with negative infinity", -math.MaxFloat64, math.Inf(-1)),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(LessThan(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 5, nil),
+		Entry("when actual is not a number", 5, "1"),
+		Entry("when the value is not a number", "5", 1),
+		Entry("when actual is greater than expected", 5, 10),
+		Entry("when actual is the same as expected", 5, float64(5)),
+		Entry("when actual is a uint greater than a negative int", -1, uint(0)),
+		Entry("when actual is NaN", 5, math.NaN()),
+	)
+})
@@ -0,0 +1,88 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MapContaining returns a new matcher that will match maps containing the
+// provided keys and values, ignoring any other keys. The arguments are pairs
+// of a key and its value, which can be a matcher or a value that is compared
+// using reflect.DeepEqual. Nested maps, such as decoded JSON, are matched as
+// subsets of the actual value, while maps passed to the other matchers are
+// compared using reflect.DeepEqual.
+func MapContaining(keysAndValues ...interface{}) SupportedKindsMatcher {
+	return &mapContaining{keysAndValues}
+}
+
+type mapContaining struct {
+	keysAndValues []interface{}
+}
+
+// SupportedKinds returns all the kinds the map containing matcher supports;
+// no kinds are supported if a key is missing its value
+func (m *mapContaining) SupportedKinds() map[reflect.Kind]struct{} {
+	if len(m.keysAndValues)%2 != 0 {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return map[reflect.Kind]struct{}{
+		reflect.Map: {},
+	}
+}
+
+// Match return true if the map contains each of the provided keys and
+// their values match; otherwise false
+func (m *mapContaining) Match(value interface{}) bool {
+	if value == nil || len(m.keysAndValues)%2 != 0 {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map:
+		for i := 0; i < len(m.keysAndValues); i += 2 {
+			actual, ok := mapIndex(v, m.keysAndValues[i])
+			if !ok || !matchesEntry(m.keysAndValues[i+1], actual) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the map containing matcher expects
+func (m *mapContaining) String() string {
+	keys := make([]interface{}, 0, len(m.keysAndValues)/2)
+	values := make([]interface{}, 0, len(m.keysAndValues)/2)
+	for i := 0; i+1 < len(m.keysAndValues); i += 2 {
+		keys = append(keys, m.keysAndValues[i])
+		values = append(values, m.keysAndValues[i+1])
+	}
+
+	if len(m.keysAndValues)%2 != 0 {
+		return fmt.Sprintf("map containing {%v} with a missing value for key %v", describeEntries(keys, values), formatValue(m.keysAndValues[len(m.keysAndValues)-1]))
+	}
+
+	return fmt.Sprintf("map containing {%v}", describeEntries(keys, values))
+}
+
+// Explain returns an explanation of why the value does not match the map containing matcher
+func (m *mapContaining) Explain(value interface{}) string {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Map && len(m.keysAndValues)%2 == 0 {
+		for i := 0; i < len(m.keysAndValues); i += 2 {
+			actual, ok := mapIndex(v, m.keysAndValues[i])
+			if !ok {
+				return fmt.Sprintf("expected %v, but key %v is missing", m, formatValue(m.keysAndValues[i]))
+			}
+
+			if !matchesEntry(m.keysAndValues[i+1], actual) {
+				return fmt.Sprintf("key %v: %v", formatValue(m.keysAndValues[i]), explainValue(m.keysAndValues[i+1], actual))
+			}
+		}
+	}
+
+	return explain(m, value)
+}
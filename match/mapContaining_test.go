@@ -0,0 +1,85 @@
+package match
+
+import (
+	"encoding/json"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("mapContaining", func() {
+	Describe("MapContaining", func() {
+		It("returns a mapContaining struct", func() {
+			actual := MapContaining()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(mapContaining)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := MapContaining("a", 1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Map: {},
+				}))
+		})
+
+		It("returns no kinds when a key is missing its value", func() {
+			actual := MapContaining("a", 1, "b").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(keysAndValues []interface{}, actual interface{}) {
+			gomega.Expect(MapContaining(keysAndValues...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when there are no keys", []interface{}{}, map[string]int{"a": 1}),
+		Entry("when the map contains the values", []interface{}{"a", 1}, map[string]int{"a": 1, "b": 2}),
+		Entry("when the values match the matchers", []interface{}{"a", LessThan(2), "b", Anything()}, map[string]int{"a": 1, "b": 2}),
+		Entry("when the map has a defined key type", []interface{}{"a", 1}, map[namedString]int{"a": 1}),
+		Entry("when the value is nil", []interface{}{"a", nil}, map[string]interface{}{"a": nil}),
+		Entry("when a nested map is a subset", []interface{}{"user", map[string]interface{}{"name": "mocka"}}, map[string]interface{}{
+			"user": map[string]interface{}{"name": "mocka", "id": 12},
+		}),
+		Entry("when decoded json is a deep subset", []interface{}{
+			"event", "created",
+			"user", map[string]interface{}{
+				"name":    StringPrefix("mo"),
+				"address": map[string]interface{}{"city": "St. Louis"},
+			},
+		}, decodeJSON(`{"event": "created", "at": 1600000000, "user": {"name": "mocka", "address": {"city": "St. Louis", "zip": "63101"}}}`)),
+	)
+
+	DescribeTable("Match returns false",
+		func(keysAndValues []interface{}, actual interface{}) {
+			gomega.Expect(MapContaining(keysAndValues...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", []interface{}{}, nil),
+		Entry("when actual is not a map", []interface{}{}, "a"),
+		Entry("when a key is missing its value", []interface{}{"a"}, map[string]int{"a": 1}),
+		Entry("when the map does not contain a key", []interface{}{"c", 1}, map[string]int{"a": 1}),
+		Entry("when a value is different", []interface{}{"a", 2}, map[string]int{"a": 1}),
+		Entry("when a value does not match the matcher", []interface{}{"a", GreaterThan(2)}, map[string]int{"a": 1}),
+		Entry("when the value is not nil", []interface{}{"a", nil}, map[string]interface{}{"a": 1}),
+		Entry("when a nested map is not a subset", []interface{}{"user", map[string]interface{}{"name": "gomock"}}, map[string]interface{}{
+			"user": map[string]interface{}{"name": "mocka", "id": 12},
+		}),
+		Entry("when a nested value is not a map", []interface{}{"user", map[string]interface{}{"name": "mocka"}}, map[string]interface{}{"user": "mocka"}),
+	)
+})
+
+// decodeJSON returns the json decoded into a map
+func decodeJSON(s string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		panic(err)
+	}
+
+	return m
+}
@@ -0,0 +1,83 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MapOf returns a new matcher that will match maps with exactly the provided
+// keys, where the value of each key matches its matcher
+func MapOf(entries map[interface{}]SupportedKindsMatcher) SupportedKindsMatcher {
+	return &mapOf{entries}
+}
+
+type mapOf struct {
+	entries map[interface{}]SupportedKindsMatcher
+}
+
+// SupportedKinds returns all the kinds the map of matcher supports
+func (mapOf) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Map: {},
+	}
+}
+
+// Match return true if the map has the same keys as the provided entries
+// and each value matches the matcher of its key; otherwise false
+func (m *mapOf) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map:
+		if v.Len() != len(m.entries) {
+			return false
+		}
+
+		for key, matcher := range m.entries {
+			actual, ok := mapIndex(v, key)
+			if !ok || matcher == nil || !matchesValue(matcher, actual) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the map of matcher expects
+func (m *mapOf) String() string {
+	keys := make([]interface{}, 0, len(m.entries))
+	matchers := make([]interface{}, 0, len(m.entries))
+	for key, matcher := range m.entries {
+		keys = append(keys, key)
+		matchers = append(matchers, matcher)
+	}
+
+	return fmt.Sprintf("map of {%v}", describeEntries(keys, matchers))
+}
+
+// Explain returns an explanation of why the value does not match the map of matcher
+func (m *mapOf) Explain(value interface{}) string {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Map {
+		if v.Len() != len(m.entries) {
+			return fmt.Sprintf("expected %v, got %v with %v entries", m, formatValue(value), v.Len())
+		}
+
+		for key, matcher := range m.entries {
+			actual, ok := mapIndex(v, key)
+			if !ok {
+				return fmt.Sprintf("expected %v, but key %v is missing", m, formatValue(key))
+			}
+
+			if matcher == nil || !matchesValue(matcher, actual) {
+				return fmt.Sprintf("key %v: %v", formatValue(key), explainValue(matcher, actual))
+			}
+		}
+	}
+
+	return explain(m, value)
+}
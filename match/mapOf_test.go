@@ -0,0 +1,54 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("mapOf", func() {
+	Describe("MapOf", func() {
+		It("returns a mapOf struct", func() {
+			actual := MapOf(nil)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(mapOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := MapOf(nil).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Map: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(entries map[interface{}]SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(MapOf(entries).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the map is empty", map[interface{}]SupportedKindsMatcher{}, map[string]int{}),
+		Entry("when the keys are the same and the values match", map[interface{}]SupportedKindsMatcher{"a": GreaterThan(1), "b": Exactly(2)}, map[string]int{"a": 5, "b": 2}),
+		Entry("when the map has a defined key type", map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}, map[namedString]int{"a": 1}),
+		Entry("when the map has interface values", map[interface{}]SupportedKindsMatcher{"name": StringPrefix("mo")}, map[string]interface{}{"name": "mocka"}),
+	)
+
+	DescribeTable("Match returns false",
+		func(entries map[interface{}]SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(MapOf(entries).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", map[interface{}]SupportedKindsMatcher{}, nil),
+		Entry("when actual is not a map", map[interface{}]SupportedKindsMatcher{}, []int{}),
+		Entry("when the map has extra keys", map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}, map[string]int{"a": 1, "b": 2}),
+		Entry("when the map is missing a key", map[interface{}]SupportedKindsMatcher{"a": Exactly(1), "b": Exactly(2)}, map[string]int{"a": 1, "c": 2}),
+		Entry("when a value does not match", map[interface{}]SupportedKindsMatcher{"a": Exactly(1)}, map[string]int{"a": 2}),
+		Entry("when a key has a different kind", map[interface{}]SupportedKindsMatcher{1: Exactly(1)}, map[string]int{"1": 1}),
+		Entry("when the matcher does not support the value kind", map[interface{}]SupportedKindsMatcher{"a": StringPrefix("1")}, map[string]int{"a": 1}),
+		Entry("when the matcher is nil", map[interface{}]SupportedKindsMatcher{"a": nil}, map[string]int{"a": 1}),
+	)
+})
@@ -0,0 +1,58 @@
+package match
+
+import (
+	"reflect"
+)
+
+// mapIndex returns the value stored in the map for the key and true if it exists;
+// otherwise false. Keys are converted to the key type of the map when they have
+// the same kind, so untyped keys can be used for maps with defined key types.
+func mapIndex(m reflect.Value, key interface{}) (reflect.Value, bool) {
+	k := reflect.ValueOf(key)
+	keyType := m.Type().Key()
+
+	switch {
+	case !k.IsValid():
+		if !isNillable(keyType.Kind()) {
+			return reflect.Value{}, false
+		}
+
+		k = reflect.Zero(keyType)
+	case k.Type().AssignableTo(keyType):
+	case k.Kind() == keyType.Kind() && k.Type().ConvertibleTo(keyType):
+		k = k.Convert(keyType)
+	default:
+		return reflect.Value{}, false
+	}
+
+	v := m.MapIndex(k)
+	return v, v.IsValid()
+}
+
+// matchesEntry returns true if the actual value matches the expected value
+// like matchesValue, except that a map is matched as a subset of the actual map
+func matchesEntry(expected interface{}, actual reflect.Value) bool {
+	if actual.Kind() == reflect.Interface && !actual.IsNil() {
+		actual = actual.Elem()
+	}
+
+	if e := reflect.ValueOf(expected); e.Kind() == reflect.Map && actual.Kind() == reflect.Map {
+		return containsEntries(actual, e)
+	}
+
+	return matchesValue(expected, actual)
+}
+
+// containsEntries returns true if every entry of the expected map
+// exists in the actual map and matches its value
+func containsEntries(actual reflect.Value, expected reflect.Value) bool {
+	iter := expected.MapRange()
+	for iter.Next() {
+		v, ok := mapIndex(actual, iter.Key().Interface())
+		if !ok || !matchesEntry(iter.Value().Interface(), v) {
+			return false
+		}
+	}
+
+	return true
+}
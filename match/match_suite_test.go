@@ -4,12 +4,12 @@ import (
 	"testing"
 
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
 )
 
 func TestMocka(t *testing.T) {
-	RegisterFailHandler(Fail)
+	gomega.RegisterFailHandler(Fail)
 	format.TruncatedDiff = false
 	RunSpecs(t, "Match Testing Suite")
 }
@@ -0,0 +1,42 @@
+package match
+
+import (
+	"math"
+	"reflect"
+)
+
+// NaN returns a new matcher that will match floats that are not a number
+func NaN() SupportedKindsMatcher {
+	return &nan{}
+}
+
+type nan struct {
+}
+
+// SupportedKinds returns all the kinds the NaN matcher supports
+func (nan) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Float32: {},
+		reflect.Float64: {},
+	}
+}
+
+// Match returns true if actual is a float that is not a number; otherwise false
+func (nan) Match(value interface{}) bool {
+	actual, ok := numericValue(value)
+	if !ok || !actual.isFloat() {
+		return false
+	}
+
+	return math.IsNaN(actual.f)
+}
+
+// String returns a description of what the NaN matcher expects
+func (nan) String() string {
+	return "NaN"
+}
+
+// Explain returns an explanation of why the value does not match the NaN matcher
+func (m nan) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,52 @@
+package match
+
+import (
+	"math"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("nan", func() {
+	Describe("NaN", func() {
+		It("returns a nan struct", func() {
+			actual := NaN()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(nan)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the float kinds", func() {
+			actual := NaN().SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Float32: {},
+					reflect.Float64: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(actual interface{}) {
+			gomega.Expect(NaN().Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("with float64", math.NaN()),
+		Entry("with float32", float32(math.NaN())),
+		Entry("with a defined float type", namedFloat(math.NaN())),
+	)
+
+	DescribeTable("Match returns false",
+		func(actual interface{}) {
+			gomega.Expect(NaN().Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is not a number", "NaN"),
+		Entry("when actual is a number", 1.5),
+		Entry("when actual is infinite", math.Inf(1)),
+		Entry("when actual is an int", 0),
+	)
+})
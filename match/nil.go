@@ -32,3 +32,13 @@ func (nilMatcher) Match(value interface{}) bool {
 	v := reflect.ValueOf(value)
 	return v.IsValid() && v.IsNil()
 }
+
+// String returns a description of what the nil matcher expects
+func (nilMatcher) String() string {
+	return "nil"
+}
+
+// Explain returns an explanation of why the value does not match the nil matcher
+func (m nilMatcher) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("nil", func() {
@@ -13,7 +13,7 @@ var _ = Describe("nil", func() {
 		It("returns a nilMatcher struct", func() {
 			actual := Nil()
 
-			Expect(actual).To(BeAssignableToTypeOf(new(nilMatcher)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(nilMatcher)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("nil", func() {
 		It("returns all support kinds in go", func() {
 			actual := Nil().SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Chan:      {},
 					reflect.Func:      {},
@@ -35,7 +35,7 @@ var _ = Describe("nil", func() {
 
 	DescribeTable("Match returns true",
 		func(value interface{}) {
-			Expect(Nil().Match(value)).To(BeTrue())
+			gomega.Expect(Nil().Match(value)).To(gomega.BeTrue())
 		},
 		Entry("with nil", nil),
 		Entry("with nil chan", (chan int)(nil)),
@@ -48,7 +48,7 @@ var _ = Describe("nil", func() {
 
 	DescribeTable("Match returns false",
 		func(value interface{}) {
-			Expect(Nil().Match(value)).To(BeFalse())
+			gomega.Expect(Nil().Match(value)).To(gomega.BeFalse())
 		},
 		Entry("with non nil chan", make(chan int)),
 		Entry("with non nil func", func() {}),
@@ -0,0 +1,43 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Not returns a new matcher that will match if the provided matcher does not match
+func Not(matcher SupportedKindsMatcher) SupportedKindsMatcher {
+	return &not{matcher}
+}
+
+type not struct {
+	matcher SupportedKindsMatcher
+}
+
+// SupportedKinds returns the kinds supported by the negated matcher
+func (m *not) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.matcher == nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return m.matcher.SupportedKinds()
+}
+
+// Match returns true if the negated matcher does not match the value; otherwise false
+func (m *not) Match(value interface{}) bool {
+	if m.matcher == nil {
+		return false
+	}
+
+	return !m.matcher.Match(value)
+}
+
+// String returns a description of what the not matcher expects
+func (m *not) String() string {
+	return fmt.Sprintf("not %v", describeValue(m.matcher))
+}
+
+// Explain returns an explanation of why the value does not match the not matcher
+func (m *not) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,49 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("not", func() {
+	Describe("Not", func() {
+		It("returns a not struct", func() {
+			actual := Not(Anything())
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(not)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds of the negated matcher", func() {
+			actual := Not(StringPrefix("A")).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+				}))
+		})
+
+		It("returns no kinds when the matcher is nil", func() {
+			gomega.Expect(Not(nil).SupportedKinds()).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(Not(matcher).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the matcher does not match", StringContaining("internal"), "/api/users"),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual interface{}) {
+			gomega.Expect(Not(matcher).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when the matcher matches", StringContaining("internal"), "/api/internal"),
+		Entry("when the matcher is nil", nil, "A"),
+	)
+})
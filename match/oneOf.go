@@ -0,0 +1,71 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// OneOf returns a new matcher that will match if the value is deep equal
+// to one of the provided values
+func OneOf(values ...interface{}) SupportedKindsMatcher {
+	return &oneOf{values}
+}
+
+type oneOf struct {
+	values []interface{}
+}
+
+// SupportedKinds returns the kinds of the provided values. Interfaces are
+// always supported and nil values support all the kinds that can be nil.
+func (m *oneOf) SupportedKinds() map[reflect.Kind]struct{} {
+	kinds := map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+	}
+
+	for _, value := range m.values {
+		if value == nil {
+			for k := range nillableKinds() {
+				kinds[k] = struct{}{}
+			}
+			continue
+		}
+
+		kinds[reflect.TypeOf(value).Kind()] = struct{}{}
+	}
+
+	return kinds
+}
+
+// Match returns true when the value is equal to one of the
+// values using reflect.DeepEqual
+func (m *oneOf) Match(value interface{}) bool {
+	for _, v := range m.values {
+		if v == nil && value != nil {
+			if actual := reflect.ValueOf(value); isNillable(actual.Kind()) && actual.IsNil() {
+				return true
+			}
+		}
+
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a description of what the one of matcher expects
+func (m *oneOf) String() string {
+	return fmt.Sprintf("one of (%v)", describeValues(m.values))
+}
+
+// Explain returns an explanation of why the value does not match the one of matcher
+func (m *oneOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// isNillable returns true if the kind can be nil
+func isNillable(kind reflect.Kind) bool {
+	_, ok := nillableKinds()[kind]
+	return ok
+}
@@ -0,0 +1,67 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("oneOf", func() {
+	Describe("OneOf", func() {
+		It("returns a oneOf struct", func() {
+			actual := OneOf("A")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(oneOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kinds of the values", func() {
+			actual := OneOf("A", 1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Int:       {},
+					reflect.String:    {},
+				}))
+		})
+
+		It("returns the nillable kinds for a nil value", func() {
+			actual := OneOf(nil).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Chan:      {},
+					reflect.Func:      {},
+					reflect.Interface: {},
+					reflect.Map:       {},
+					reflect.Ptr:       {},
+					reflect.Slice:     {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(values []interface{}, actual interface{}) {
+			gomega.Expect(OneOf(values...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the value is the first value", []interface{}{"GET", "HEAD"}, "GET"),
+		Entry("when the value is the last value", []interface{}{"GET", "HEAD"}, "HEAD"),
+		Entry("when the value is a deep equal slice", []interface{}{[]int{1}, []int{2}}, []int{2}),
+		Entry("when the value is nil", []interface{}{nil, []int{}}, nil),
+		Entry("when the value is a typed nil", []interface{}{nil, []int{}}, []int(nil)),
+	)
+
+	DescribeTable("Match returns false",
+		func(values []interface{}, actual interface{}) {
+			gomega.Expect(OneOf(values...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when there are no values", []interface{}{}, "GET"),
+		Entry("when the value is not one of the values", []interface{}{"GET", "HEAD"}, "POST"),
+		Entry("when the value is a different type", []interface{}{1, 2}, int64(1)),
+		Entry("when the value is not nil", []interface{}{nil}, 0),
+	)
+})
@@ -0,0 +1,104 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// PointsTo returns a new matcher that will match pointers whose pointee
+// matches the provided matcher. Pointers to pointers are dereferenced
+// until the matcher matches or a non-pointer value is reached.
+func PointsTo(matcher SupportedKindsMatcher) SupportedKindsMatcher {
+	return &pointsTo{matcher}
+}
+
+type pointsTo struct {
+	matcher SupportedKindsMatcher
+}
+
+// SupportedKinds returns all the kinds the points to matcher supports;
+// no kinds are supported if the matcher is nil
+func (m *pointsTo) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.matcher == nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+	}
+}
+
+// Match returns true if the value actual points to, at any level of
+// indirection, matches the provided matcher; otherwise false
+func (m *pointsTo) Match(value interface{}) bool {
+	if m.matcher == nil {
+		return false
+	}
+
+	kinds := m.matcher.SupportedKinds()
+	visited := map[uintptr]struct{}{}
+	for v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && !v.IsNil(); {
+		if _, seen := visited[v.Pointer()]; seen {
+			return false
+		}
+		visited[v.Pointer()] = struct{}{}
+
+		v = v.Elem()
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+
+		if _, ok := kinds[v.Kind()]; ok && v.CanInterface() && m.matcher.Match(v.Interface()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a description of what the points to matcher expects
+func (m *pointsTo) String() string {
+	return fmt.Sprintf("pointer to %v", describeValue(m.matcher))
+}
+
+// Explain returns an explanation of why the value, or the
+// value it points to, does not match the points to matcher
+func (m *pointsTo) Explain(value interface{}) string {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Ptr || v.IsNil() || m.matcher == nil {
+		return explain(m, value)
+	}
+
+	visited := map[uintptr]struct{}{}
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		if _, seen := visited[v.Pointer()]; seen {
+			return fmt.Sprintf("expected %v, got a pointer that points to itself", m)
+		}
+		visited[v.Pointer()] = struct{}{}
+
+		v = v.Elem()
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+	}
+
+	if v.Kind() == reflect.Ptr {
+		return fmt.Sprintf("expected %v, got a pointer to a nil %v", m, v.Type())
+	}
+
+	if !v.CanInterface() {
+		return explain(m, value)
+	}
+
+	return fmt.Sprintf("pointee: %v", explainValue(m.matcher, v))
+}
+
+// priority returns the priority of the matcher applied to the pointee
+func (m *pointsTo) priority() float64 {
+	if m.matcher == nil {
+		return 0
+	}
+
+	return Priority(m.matcher)
+}
@@ -0,0 +1,104 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("pointsTo", func() {
+	Describe("PointsTo", func() {
+		It("returns a pointsTo struct", func() {
+			actual := PointsTo(Exactly(1))
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(pointsTo)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns interface and pointer kinds", func() {
+			gomega.Expect(PointsTo(Exactly(1)).SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+
+		It("returns no kinds when the matcher is nil", func() {
+			gomega.Expect(PointsTo(nil).SupportedKinds()).To(gomega.BeEmpty())
+		})
+	})
+
+	Describe("priority", func() {
+		It("returns the priority of the matcher", func() {
+			gomega.Expect(Priority(PointsTo(Exactly(1)))).To(gomega.Equal(Priority(Exactly(1))))
+		})
+
+		It("returns 0 when the matcher is nil", func() {
+			gomega.Expect(Priority(PointsTo(nil))).To(gomega.Equal(float64(0)))
+		})
+	})
+
+	It("does not loop forever on a pointer that points to itself", func() {
+		type cycle *interface{}
+		var v interface{}
+		v = cycle(&v)
+
+		gomega.Expect(PointsTo(Exactly(1)).Match(&v)).To(gomega.BeFalse())
+	})
+
+	DescribeTable("Match returns true",
+		func(matcher SupportedKindsMatcher, actual func() interface{}) {
+			gomega.Expect(PointsTo(matcher).Match(actual())).To(gomega.BeTrue())
+		},
+		Entry("when the pointee matches", Exactly(1), func() interface{} {
+			v := 1
+			return &v
+		}),
+		Entry("when the pointee of a pointer to a pointer matches", GreaterThan(0), func() interface{} {
+			v := 1
+			p := &v
+			return &p
+		}),
+		Entry("when the pointee is a nil pointer and the matcher is Nil", Nil(), func() interface{} {
+			var p *int
+			return &p
+		}),
+		Entry("when the pointee is an interface whose value matches", StringPrefix("mo"), func() interface{} {
+			var v interface{} = "mocka"
+			return &v
+		}),
+		Entry("when the pointee is a struct that matches", Fields(map[string]SupportedKindsMatcher{
+			"City": Exactly("Berlin"),
+		}), func() interface{} {
+			return &fieldsAddress{City: "Berlin"}
+		}),
+	)
+
+	DescribeTable("Match returns false",
+		func(matcher SupportedKindsMatcher, actual func() interface{}) {
+			gomega.Expect(PointsTo(matcher).Match(actual())).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", Exactly(1), func() interface{} { return nil }),
+		Entry("when actual is a nil pointer", Exactly(1), func() interface{} { return (*int)(nil) }),
+		Entry("when actual is not a pointer", Exactly(1), func() interface{} { return 1 }),
+		Entry("when the matcher is nil", nil, func() interface{} {
+			v := 1
+			return &v
+		}),
+		Entry("when the pointee does not match", Exactly(1), func() interface{} {
+			v := 2
+			return &v
+		}),
+		Entry("when the pointee kind is not supported by the matcher", StringPrefix("1"), func() interface{} {
+			v := 1
+			return &v
+		}),
+		Entry("when a pointer to a pointer is nil", Exactly(1), func() interface{} {
+			var p *int
+			return &p
+		}),
+	)
+})
@@ -2,22 +2,44 @@ package match
 
 import "reflect"
 
+// priorityMatcher describes a matcher whose priority is derived from
+// the matchers it is composed of
+type priorityMatcher interface {
+	priority() float64
+}
+
 // Priority returns the matchers priority to be compared against
 func Priority(m SupportedKindsMatcher) float64 {
+	if pm, ok := m.(priorityMatcher); ok {
+		return pm.priority()
+	}
+
 	if p, exists := priorities[reflect.TypeOf(m)]; exists {
 		return p
 	}
 
-	return float64(len(priorities)) + 1
+	return customPriority
 }
 
-// priorities defines the priority ranking for custom matchers
+// customPriority is the priority of matchers that are not built in, which
+// ranks them above every built in matcher
+const customPriority = 27
+
+// priorities defines the priority ranking for custom matchers. The matchers
+// added after v2.0.1 are ranked between the existing priorities, so the
+// priorities of the existing matchers do not change.
 var priorities = map[reflect.Type]float64{
 	// exact value matchers
+	reflect.TypeOf(new(same)):       25.5,
 	reflect.TypeOf(new(exactly)):    25,
+	reflect.TypeOf(new(equal)):      24.5,
 	reflect.TypeOf(new(nilMatcher)): 24,
+	reflect.TypeOf(new(oneOf)):      23.8,
+	reflect.TypeOf(new(nan)):        23.6,
 
 	// numeric matchers
+	reflect.TypeOf(new(inDelta)):                   23.4,
+	reflect.TypeOf(new(between)):                   23.2,
 	reflect.TypeOf(new(floatGreaterThan)):          23,
 	reflect.TypeOf(new(floatLessThan)):             22,
 	reflect.TypeOf(new(floatGreaterThanOrEqualTo)): 21,
@@ -33,23 +55,72 @@ var priorities = map[reflect.Type]float64{
 	reflect.TypeOf(new(uintGreaterThanOrEqualTo)): 13,
 	reflect.TypeOf(new(uintLessThanOrEqualTo)):    12,
 
+	reflect.TypeOf(new(greaterThan)): 11.5,
+	reflect.TypeOf(new(lessThan)):    11.45,
+	reflect.TypeOf(new(finite)):      11.4,
+
+	// time matchers
+	reflect.TypeOf(new(timeWithin)):      11.35,
+	reflect.TypeOf(new(durationBetween)): 11.3,
+	reflect.TypeOf(new(timeBefore)):      11.25,
+	reflect.TypeOf(new(timeAfter)):       11.2,
+	reflect.TypeOf(new(timeZone)):        11.15,
+
 	// string matchers
-	reflect.TypeOf(new(stringPrefix)):     11,
-	reflect.TypeOf(new(stringSuffix)):     10,
-	reflect.TypeOf(new(stringContaining)): 9,
+	reflect.TypeOf(new(stringEqualFold)):               11.1,
+	reflect.TypeOf(new(stringEqualIgnoringWhitespace)): 11.05,
+	reflect.TypeOf(new(stringPrefix)):                  11,
+	reflect.TypeOf(new(stringSuffix)):                  10,
+	reflect.TypeOf(new(stringContaining)):              9,
+	reflect.TypeOf(new(stringMatching)):                8.5,
+	reflect.TypeOf(new(stringLengthOf)):                8.45,
+
+	// JSON & bytes matchers
+	reflect.TypeOf(new(bytesEqual)):      8.4,
+	reflect.TypeOf(new(jsonEq)):          8.35,
+	reflect.TypeOf(new(jsonPath)):        8.3,
+	reflect.TypeOf(new(bytesContaining)): 8.25,
+	reflect.TypeOf(new(readerContent)):   8.2,
+
+	// error matchers
+	reflect.TypeOf(new(errorIs)):         8.15,
+	reflect.TypeOf(new(errorAs)):         8.1,
+	reflect.TypeOf(new(errorContaining)): 8.05,
 
 	// multi-purpse matchers
 	reflect.TypeOf(new(lengthOf)): 8,
 	reflect.TypeOf(new(empty)):    7,
 
+	// struct matchers
+	reflect.TypeOf(new(HTTPRequestMatcher)): 6.5,
+	reflect.TypeOf(new(fieldsMatcher)):      6.45,
+
 	// map & slice matchers
-	reflect.TypeOf(new(keysContaining)):     6,
-	reflect.TypeOf(new(elementsContaining)): 5,
+	reflect.TypeOf(new(mapOf)):                   6.4,
+	reflect.TypeOf(new(consistsOf)):              6.35,
+	reflect.TypeOf(new(mapContaining)):           6.3,
+	reflect.TypeOf(new(keysContaining)):          6,
+	reflect.TypeOf(new(valuesContaining)):        5.5,
+	reflect.TypeOf(new(elementsContaining)):      5,
+	reflect.TypeOf(new(containsElementMatching)): 4.5,
+	reflect.TypeOf(new(every)):                   4.45,
+
+	// context matchers
+	reflect.TypeOf(new(contextWithValue)):      4.4,
+	reflect.TypeOf(new(contextDeadlineWithin)): 4.35,
+	reflect.TypeOf(new(contextWithDeadline)):   4.3,
+	reflect.TypeOf(new(contextDone)):           4.25,
+	reflect.TypeOf(new(contextNotDone)):        4.2,
+
+	// logical matchers
+	reflect.TypeOf(new(not)): 4.15,
 
 	// type matchers
 	reflect.TypeOf(new(implementerOf)):  4,
 	reflect.TypeOf(new(convertibleTo)):  3,
 	reflect.TypeOf(new(typeOf)):         2,
+	reflect.TypeOf(new(capture)):        1.5,
+	reflect.TypeOf(new(captureAll)):     1.45,
 	reflect.TypeOf(new(anythingButNil)): 1,
 	reflect.TypeOf(new(anything)):       0,
 }
@@ -5,17 +5,23 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("priority", func() {
 	DescribeTable("returns priority",
 		func(matcher SupportedKindsMatcher, actual float64) {
-			Expect(Priority(matcher)).To(Equal(actual))
+			gomega.Expect(Priority(matcher)).To(gomega.Equal(actual))
 		},
 		Entry("priority for custom matchers", new(mockMatcher), float64(27)),
+		Entry("priority for the same matcher", new(same), float64(25.5)),
 		Entry("priority for the exactly matcher", new(exactly), float64(25)),
+		Entry("priority for the equal matcher", new(equal), float64(24.5)),
 		Entry("priority for the nilMatcher matcher", new(nilMatcher), float64(24)),
+		Entry("priority for the oneOf matcher", new(oneOf), float64(23.8)),
+		Entry("priority for the nan matcher", new(nan), float64(23.6)),
+		Entry("priority for the inDelta matcher", new(inDelta), float64(23.4)),
+		Entry("priority for the between matcher", new(between), float64(23.2)),
 		Entry("priority for the floatGreaterThan matcher", new(floatGreaterThan), float64(23)),
 		Entry("priority for the floatLessThan matcher", new(floatLessThan), float64(22)),
 		Entry("priority for the floatGreaterThanOrEqualTo matcher", new(floatGreaterThanOrEqualTo), float64(21)),
@@ -28,16 +34,52 @@ var _ = Describe("priority", func() {
 		Entry("priority for the uintLessThan matcher", new(uintLessThan), float64(14)),
 		Entry("priority for the uintGreaterThanOrEqualTo matcher", new(uintGreaterThanOrEqualTo), float64(13)),
 		Entry("priority for the uintLessThanOrEqualTo matcher", new(uintLessThanOrEqualTo), float64(12)),
+		Entry("priority for the greaterThan matcher", new(greaterThan), float64(11.5)),
+		Entry("priority for the lessThan matcher", new(lessThan), float64(11.45)),
+		Entry("priority for the finite matcher", new(finite), float64(11.4)),
+		Entry("priority for the timeWithin matcher", new(timeWithin), float64(11.35)),
+		Entry("priority for the durationBetween matcher", new(durationBetween), float64(11.3)),
+		Entry("priority for the timeBefore matcher", new(timeBefore), float64(11.25)),
+		Entry("priority for the timeAfter matcher", new(timeAfter), float64(11.2)),
+		Entry("priority for the timeZone matcher", new(timeZone), float64(11.15)),
+		Entry("priority for the stringEqualFold matcher", new(stringEqualFold), float64(11.1)),
+		Entry("priority for the stringEqualIgnoringWhitespace matcher", new(stringEqualIgnoringWhitespace), float64(11.05)),
 		Entry("priority for the stringPrefix matcher", new(stringPrefix), float64(11)),
 		Entry("priority for the stringSuffix matcher", new(stringSuffix), float64(10)),
 		Entry("priority for the stringContaining matcher", new(stringContaining), float64(9)),
+		Entry("priority for the stringMatching matcher", new(stringMatching), float64(8.5)),
+		Entry("priority for the stringLengthOf matcher", new(stringLengthOf), float64(8.45)),
+		Entry("priority for the bytesEqual matcher", new(bytesEqual), float64(8.4)),
+		Entry("priority for the jsonEq matcher", new(jsonEq), float64(8.35)),
+		Entry("priority for the jsonPath matcher", new(jsonPath), float64(8.3)),
+		Entry("priority for the bytesContaining matcher", new(bytesContaining), float64(8.25)),
+		Entry("priority for the readerContent matcher", new(readerContent), float64(8.2)),
+		Entry("priority for the errorIs matcher", new(errorIs), float64(8.15)),
+		Entry("priority for the errorAs matcher", new(errorAs), float64(8.1)),
+		Entry("priority for the errorContaining matcher", new(errorContaining), float64(8.05)),
 		Entry("priority for the lengthOf matcher", new(lengthOf), float64(8)),
 		Entry("priority for the empty matcher", new(empty), float64(7)),
+		Entry("priority for the HTTPRequestMatcher matcher", new(HTTPRequestMatcher), float64(6.5)),
+		Entry("priority for the fieldsMatcher matcher", new(fieldsMatcher), float64(6.45)),
+		Entry("priority for the mapOf matcher", new(mapOf), float64(6.4)),
+		Entry("priority for the consistsOf matcher", new(consistsOf), float64(6.35)),
+		Entry("priority for the mapContaining matcher", new(mapContaining), float64(6.3)),
 		Entry("priority for the keysContaining matcher", new(keysContaining), float64(6)),
+		Entry("priority for the valuesContaining matcher", new(valuesContaining), float64(5.5)),
 		Entry("priority for the elementsContaining matcher", new(elementsContaining), float64(5)),
+		Entry("priority for the containsElementMatching matcher", new(containsElementMatching), float64(4.5)),
+		Entry("priority for the every matcher", new(every), float64(4.45)),
+		Entry("priority for the contextWithValue matcher", new(contextWithValue), float64(4.4)),
+		Entry("priority for the contextDeadlineWithin matcher", new(contextDeadlineWithin), float64(4.35)),
+		Entry("priority for the contextWithDeadline matcher", new(contextWithDeadline), float64(4.3)),
+		Entry("priority for the contextDone matcher", new(contextDone), float64(4.25)),
+		Entry("priority for the contextNotDone matcher", new(contextNotDone), float64(4.2)),
+		Entry("priority for the not matcher", new(not), float64(4.15)),
 		Entry("priority for the implementerOf matcher", new(implementerOf), float64(4)),
 		Entry("priority for the convertibleTo matcher", new(convertibleTo), float64(3)),
 		Entry("priority for the typeOf matcher", new(typeOf), float64(2)),
+		Entry("priority for the capture matcher", new(capture), float64(1.5)),
+		Entry("priority for the captureAll matcher", new(captureAll), float64(1.45)),
 		Entry("priority for the anythingButNil matcher", new(anythingButNil), float64(1)),
 		Entry("priority for the anything matcher", new(anything), float64(0)),
 	)
@@ -0,0 +1,57 @@
+package match
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ReaderContent returns a new matcher that will match an io.Reader when its
+// unread content, as a string, matches the provided value, which can be a
+// matcher or a value that is compared using reflect.DeepEqual. The reader is
+// left unconsumed, so only readers with a Bytes method, such as *bytes.Buffer,
+// io.ReadSeekers, such as *bytes.Reader and *os.File, readers with a
+// Reset(io.Reader) method, such as *bufio.Reader, and *io.LimitedReader can be
+// matched. Other readers cannot be read without taking their content from the
+// code under test, since the matcher cannot replace the argument, so they never match.
+func ReaderContent(value interface{}) SupportedKindsMatcher {
+	return &readerContent{value}
+}
+
+type readerContent struct {
+	value interface{}
+}
+
+// SupportedKinds returns all the kinds the reader content matcher supports
+func (readerContent) SupportedKinds() map[reflect.Kind]struct{} {
+	return readerKinds()
+}
+
+// Match returns true if the unread content of actual matches the provided value; otherwise false
+func (m *readerContent) Match(value interface{}) bool {
+	content, ok := readerValue(value)
+	if !ok {
+		return false
+	}
+
+	return matchesValue(m.value, reflect.ValueOf(content))
+}
+
+// String returns a description of what the reader content matcher expects
+func (m *readerContent) String() string {
+	return fmt.Sprintf("reader with content matching %v", describeValue(m.value))
+}
+
+// Explain returns an explanation of why the value does not match the reader content matcher
+func (m *readerContent) Explain(value interface{}) string {
+	content, ok := readerValue(value)
+	if !ok {
+		if _, isReader := value.(io.Reader); isReader {
+			return fmt.Sprintf("expected %v, got %T which cannot be read without consuming it", m, value)
+		}
+
+		return explain(m, value)
+	}
+
+	return fmt.Sprintf("content: %v", explainValue(m.value, reflect.ValueOf(content)))
+}
@@ -0,0 +1,107 @@
+package match
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing/iotest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("readerContent", func() {
+	Describe("ReaderContent", func() {
+		It("returns a readerContent struct", func() {
+			actual := ReaderContent("mocka")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(readerContent)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns interface and pointer kinds", func() {
+			gomega.Expect(ReaderContent("mocka").SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Interface: {},
+					reflect.Ptr:       {},
+				}))
+		})
+	})
+
+	It("does not consume a buffer", func() {
+		buffer := bytes.NewBufferString("mocka")
+
+		gomega.Expect(ReaderContent("mocka").Match(buffer)).To(gomega.BeTrue())
+		gomega.Expect(buffer.String()).To(gomega.Equal("mocka"))
+	})
+
+	It("rewinds a read seeker to its original offset", func() {
+		reader := strings.NewReader("mocka")
+		_, _ = reader.Seek(2, io.SeekStart)
+
+		gomega.Expect(ReaderContent("cka").Match(reader)).To(gomega.BeTrue())
+
+		rest, err := io.ReadAll(reader)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(string(rest)).To(gomega.Equal("cka"))
+	})
+
+	It("replays the content of a reader that can be reset", func() {
+		reader := bufio.NewReader(strings.NewReader("mocka"))
+
+		gomega.Expect(ReaderContent("mocka").Match(reader)).To(gomega.BeTrue())
+
+		rest, err := io.ReadAll(reader)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(string(rest)).To(gomega.Equal("mocka"))
+	})
+
+	It("replays the content of a limited reader", func() {
+		reader := &io.LimitedReader{R: strings.NewReader("mocka"), N: 4}
+
+		gomega.Expect(ReaderContent("mock").Match(reader)).To(gomega.BeTrue())
+
+		rest, err := io.ReadAll(reader)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(string(rest)).To(gomega.Equal("mock"))
+	})
+
+	It("replays the error of a reader that can be reset", func() {
+		reader := bufio.NewReader(io.MultiReader(strings.NewReader("mo"), iotest.ErrReader(errors.New("broken"))))
+
+		gomega.Expect(ReaderContent("mo").Match(reader)).To(gomega.BeFalse())
+
+		rest, err := io.ReadAll(reader)
+		gomega.Expect(err).To(gomega.MatchError("broken"))
+		gomega.Expect(string(rest)).To(gomega.Equal("mo"))
+	})
+
+	DescribeTable("Match returns true",
+		func(value interface{}, actual interface{}) {
+			gomega.Expect(ReaderContent(value).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the content of a buffer is equal", "mocka", bytes.NewBufferString("mocka")),
+		Entry("when the content of a strings reader is equal", "mocka", strings.NewReader("mocka")),
+		Entry("when the content of a bytes reader matches", StringPrefix("mo"), bytes.NewReader([]byte("mocka"))),
+		Entry("when the content is valid JSON", JSONEq(`{"a":1}`), strings.NewReader(`{ "a": 1 }`)),
+		Entry("when the content is empty", Empty(), strings.NewReader("")),
+	)
+
+	DescribeTable("Match returns false",
+		func(value interface{}, actual interface{}) {
+			gomega.Expect(ReaderContent(value).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", "mocka", nil),
+		Entry("when actual is a nil buffer", "", (*bytes.Buffer)(nil)),
+		Entry("when actual is a nil reader", "", (*strings.Reader)(nil)),
+		Entry("when actual is not a reader", "mocka", "mocka"),
+		Entry("when actual cannot be read without consuming it", "mocka", io.MultiReader(strings.NewReader("mocka"))),
+		Entry("when the content is different", "mocka", strings.NewReader("gomega")),
+		Entry("when the matcher does not match", StringPrefix("go"), strings.NewReader("mocka")),
+	)
+})
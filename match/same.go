@@ -0,0 +1,66 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Same returns a new matcher that will match the identical pointer, map, channel
+// or function as the provided value instead of comparing what they refer to.
+// Functions are compared by their code pointer, so different closures created
+// from the same function literal are considered the same.
+func Same(value interface{}) SupportedKindsMatcher {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.UnsafePointer:
+		return &same{value: value, address: v.Pointer(), valueType: v.Type()}
+	default:
+		return &same{value: value}
+	}
+}
+
+// same holds the value, so what it refers to stays alive while the matcher is
+// used, and its address, so matchers for different addresses that refer to equal
+// values are never deeply equal
+type same struct {
+	value     interface{}
+	address   uintptr
+	valueType reflect.Type
+}
+
+// SupportedKinds returns the kind of the provided value and interface; no kinds
+// are supported if the value is not a pointer, map, channel or function
+func (m *same) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.valueType == nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return map[reflect.Kind]struct{}{
+		m.valueType.Kind(): {},
+		reflect.Interface:  {},
+	}
+}
+
+// Match returns true if actual has the same type as and
+// points to the same address as the provided value; otherwise false
+func (m *same) Match(value interface{}) bool {
+	if m.valueType == nil || reflect.TypeOf(value) != m.valueType {
+		return false
+	}
+
+	return reflect.ValueOf(value).Pointer() == reflect.ValueOf(m.value).Pointer()
+}
+
+// String returns a description of what the same matcher expects
+func (m *same) String() string {
+	if m.valueType == nil {
+		return fmt.Sprintf("same as %v", formatValue(m.value))
+	}
+
+	return fmt.Sprintf("same %v as %#x", m.valueType, m.address)
+}
+
+// Explain returns an explanation of why the value does not match the same matcher
+func (m *same) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,91 @@
+package match
+
+import (
+	"reflect"
+	"unsafe"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("same", func() {
+	var (
+		value   = 1
+		pointer = &value
+		mapping = map[string]int{"a": 1}
+		channel = make(chan int)
+		fn      = func() {}
+	)
+
+	Describe("Same", func() {
+		It("returns a same struct", func() {
+			actual := Same(pointer)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(same)))
+		})
+
+		It("returns matchers that are not deeply equal for different pointers to equal values", func() {
+			first, second := 1, 1
+
+			gomega.Expect(reflect.DeepEqual(Same(&first), Same(&second))).To(gomega.BeFalse())
+			gomega.Expect(reflect.DeepEqual(Same(&first), Same(&first))).To(gomega.BeTrue())
+		})
+
+		It("keeps the value so what it refers to is not collected", func() {
+			gomega.Expect(Same(pointer).(*same).value).To(gomega.BeIdenticalTo(pointer))
+		})
+	})
+
+	DescribeTable("SupportedKinds returns the kind of the value and interface",
+		func(value interface{}, kind reflect.Kind) {
+			gomega.Expect(Same(value).SupportedKinds()).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					kind:              {},
+					reflect.Interface: {},
+				}))
+		},
+		Entry("for a pointer", pointer, reflect.Ptr),
+		Entry("for a map", mapping, reflect.Map),
+		Entry("for a channel", channel, reflect.Chan),
+		Entry("for a function", fn, reflect.Func),
+		Entry("for an unsafe pointer", unsafe.Pointer(pointer), reflect.UnsafePointer),
+	)
+
+	DescribeTable("SupportedKinds returns no kinds",
+		func(value interface{}) {
+			gomega.Expect(Same(value).SupportedKinds()).To(gomega.BeEmpty())
+		},
+		Entry("when the value is nil", nil),
+		Entry("when the value is an int", 1),
+		Entry("when the value is a slice", []int{1}),
+		Entry("when the value is a struct", fieldsAddress{}),
+	)
+
+	DescribeTable("Match returns true",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(Same(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is the same pointer", pointer, pointer),
+		Entry("when actual is the same map", mapping, mapping),
+		Entry("when actual is the same channel", channel, channel),
+		Entry("when actual is the same function", fn, fn),
+		Entry("when both are nil pointers of the same type", (*int)(nil), (*int)(nil)),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected interface{}, actual interface{}) {
+			gomega.Expect(Same(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", pointer, nil),
+		Entry("when actual is a nil pointer", pointer, (*int)(nil)),
+		Entry("when actual is a different pointer to an equal value", pointer, func() *int {
+			v := 1
+			return &v
+		}()),
+		Entry("when actual is a different but equal map", mapping, map[string]int{"a": 1}),
+		Entry("when actual is a different channel", channel, make(chan int)),
+		Entry("when actual is a different type with the same address", pointer, unsafe.Pointer(pointer)),
+		Entry("when the value is not supported", 1, 1),
+	)
+})
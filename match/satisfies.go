@@ -0,0 +1,93 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var boolType = reflect.TypeOf(true)
+
+// Satisfies returns a new matcher that will match a value when the provided
+// predicate returns true. The predicate must be a function that takes a single
+// argument and returns a bool, e.g. func(u User) bool. Values that cannot be
+// assigned to the predicate's argument will not match.
+//
+// A panic inside the predicate is re-panicked as a PredicatePanic, which
+// mocka reports as a test failure.
+func Satisfies(predicate interface{}) SupportedKindsMatcher {
+	return &satisfies{predicate}
+}
+
+type satisfies struct {
+	predicate interface{}
+}
+
+// SupportedKinds returns the kind of the predicate's argument and Interface.
+// Interface arguments support all kinds and an invalid predicate supports no kinds.
+func (m *satisfies) SupportedKinds() map[reflect.Kind]struct{} {
+	argType, ok := predicateArgument(m.predicate)
+	if !ok {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return assignableKinds(argType)
+}
+
+// Match returns the result of the predicate if the value can be assigned
+// to the predicate's argument; otherwise false
+func (m *satisfies) Match(value interface{}) bool {
+	argType, ok := predicateArgument(m.predicate)
+	if !ok {
+		return false
+	}
+
+	arg, ok := assignableValue(value, argType)
+	if !ok {
+		return false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			panic(&PredicatePanic{predicate: m.predicate, value: value, recovered: r})
+		}
+	}()
+
+	return reflect.ValueOf(m.predicate).Call([]reflect.Value{arg})[0].Bool()
+}
+
+// String returns a description of what the satisfies matcher expects
+func (m *satisfies) String() string {
+	return fmt.Sprintf("value satisfying %T", m.predicate)
+}
+
+// Explain returns an explanation of why the value does not match the satisfies matcher
+func (m *satisfies) Explain(value interface{}) string {
+	return explain(m, value)
+}
+
+// predicateArgument returns the argument type of the predicate and true
+// if the predicate is a func(T) bool; otherwise false
+func predicateArgument(predicate interface{}) (reflect.Type, bool) {
+	predicateType := reflect.TypeOf(predicate)
+	if predicateType == nil || predicateType.Kind() != reflect.Func || reflect.ValueOf(predicate).IsNil() {
+		return nil, false
+	}
+
+	if predicateType.NumIn() != 1 || predicateType.IsVariadic() || predicateType.NumOut() != 1 || predicateType.Out(0) != boolType {
+		return nil, false
+	}
+
+	return predicateType.In(0), true
+}
+
+// PredicatePanic describes a panic that occurred inside the predicate of a Satisfies matcher
+type PredicatePanic struct {
+	predicate interface{}
+	value     interface{}
+	recovered interface{}
+}
+
+// Error returns a message describing the predicate, the value it was called with and the panic
+func (p *PredicatePanic) Error() string {
+	return fmt.Sprintf("the predicate %v panicked when matching %#v: %v", reflect.TypeOf(p.predicate), p.value, p.recovered)
+}
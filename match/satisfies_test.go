@@ -0,0 +1,90 @@
+package match
+
+import (
+	"errors"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("satisfies", func() {
+	Describe("Satisfies", func() {
+		It("returns a satisfies struct", func() {
+			actual := Satisfies(func(string) bool { return true })
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(satisfies)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the kind of the predicate argument and interface", func() {
+			actual := Satisfies(func(int) bool { return true }).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Int:       {},
+					reflect.Interface: {},
+				}))
+		})
+
+		It("returns all kinds when the predicate argument is an interface", func() {
+			actual := Satisfies(func(error) bool { return true }).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(Anything().SupportedKinds()))
+		})
+
+		DescribeTable("returns no kinds when the predicate is invalid",
+			func(predicate interface{}) {
+				gomega.Expect(Satisfies(predicate).SupportedKinds()).To(gomega.BeEmpty())
+			},
+			Entry("nil", nil),
+			Entry("not a function", "string"),
+			Entry("nil function", (func(int) bool)(nil)),
+			Entry("no arguments", func() bool { return true }),
+			Entry("too many arguments", func(int, int) bool { return true }),
+			Entry("variadic argument", func(...int) bool { return true }),
+			Entry("no return value", func(int) {}),
+			Entry("non bool return value", func(int) int { return 0 }),
+		)
+	})
+
+	DescribeTable("Match returns true",
+		func(predicate interface{}, actual interface{}) {
+			gomega.Expect(Satisfies(predicate).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the predicate returns true", func(s string) bool { return len(s) == 2 }, "hi"),
+		Entry("when the value implements the interface argument", func(err error) bool { return err.Error() == "oops" }, errors.New("oops")),
+		Entry("when the value is nil and the argument can be nil", func(s []int) bool { return s == nil }, nil),
+	)
+
+	DescribeTable("Match returns false",
+		func(predicate interface{}, actual interface{}) {
+			gomega.Expect(Satisfies(predicate).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when the predicate returns false", func(s string) bool { return len(s) == 2 }, "hello"),
+		Entry("when the value is not assignable to the argument", func(s string) bool { return true }, 1),
+		Entry("when the value is nil and the argument cannot be nil", func(int) bool { return true }, nil),
+		Entry("when the predicate is invalid", func(int) int { return 0 }, 1),
+	)
+
+	It("Match panics with a PredicatePanic when the predicate panics", func() {
+		var recovered interface{}
+		func() {
+			defer func() { recovered = recover() }()
+			Satisfies(func(int) bool { panic("boom") }).Match(1)
+		}()
+
+		gomega.Expect(recovered).To(gomega.BeAssignableToTypeOf(new(PredicatePanic)))
+		gomega.Expect(recovered.(*PredicatePanic).Error()).To(gomega.Equal("the predicate func(int) bool panicked when matching 1: boom"))
+	})
+
+	Describe("PredicatePanic", func() {
+		It("returns a message describing the panic", func() {
+			p := &PredicatePanic{predicate: func(string) bool { return true }, value: "hi", recovered: "boom"}
+
+			gomega.Expect(p.Error()).To(gomega.Equal(`the predicate func(string) bool panicked when matching "hi": boom`))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -36,3 +37,26 @@ func (m *sliceOf) Match(value interface{}) bool {
 
 	return true
 }
+
+// String returns a description of what the slice of matcher expects
+func (m *sliceOf) String() string {
+	return fmt.Sprintf("elements (%v)", describeMatchers(m.matchers))
+}
+
+// Explain returns an explanation of the first element that does not match the slice of matcher
+func (m *sliceOf) Explain(value interface{}) string {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Len() != len(m.matchers) {
+			return fmt.Sprintf("expected %v elements, got %v", len(m.matchers), v.Len())
+		}
+
+		for i, matcher := range m.matchers {
+			if !matcher.Match(v.Index(i).Interface()) {
+				return fmt.Sprintf("element %v: %v", i, Explanation(matcher, v.Index(i).Interface()))
+			}
+		}
+	}
+
+	return explain(m, value)
+}
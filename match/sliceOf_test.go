@@ -5,7 +5,7 @@ import (
 	"reflect"
 
 	. "github.com/onsi/ginkgo"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("sliceOf", func() {
@@ -17,13 +17,13 @@ var _ = Describe("sliceOf", func() {
 
 	Describe("SliceOf", func() {
 		It("returns a sliceOf struct", func() {
-			Expect(matcher).To(BeAssignableToTypeOf(new(sliceOf)))
+			gomega.Expect(matcher).To(gomega.BeAssignableToTypeOf(new(sliceOf)))
 		})
 	})
 
 	Describe("SupportedKinds", func() {
 		It("returns all support kinds in go", func() {
-			Expect(matcher.SupportedKinds()).To(Equal(
+			gomega.Expect(matcher.SupportedKinds()).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Array: {},
 					reflect.Slice: {},
@@ -33,15 +33,15 @@ var _ = Describe("sliceOf", func() {
 
 	Describe("Match", func() {
 		It("returns true is all matchers are truthy", func() {
-			Expect(matcher.Match([]interface{}{1, nil, "A"})).To(BeTrue())
+			gomega.Expect(matcher.Match([]interface{}{1, nil, "A"})).To(gomega.BeTrue())
 		})
 
 		It("return false if the length of arguments do not match the length of matchers", func() {
-			Expect(matcher.Match([]interface{}{1})).To(BeFalse())
+			gomega.Expect(matcher.Match([]interface{}{1})).To(gomega.BeFalse())
 		})
 
 		It("returns false if one of the matchers is not truthy", func() {
-			Expect(matcher.Match([]interface{}{1, errors.New("a"), "A"})).To(BeFalse())
+			gomega.Expect(matcher.Match([]interface{}{1, errors.New("a"), "A"})).To(gomega.BeFalse())
 		})
 	})
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -27,10 +28,20 @@ func (m *stringContaining) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
+	switch v := reflect.ValueOf(value); v.Kind() {
 	case reflect.String:
-		return strings.Contains(value.(string), m.substring)
+		return strings.Contains(v.String(), m.substring)
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the string containing matcher expects
+func (m *stringContaining) String() string {
+	return fmt.Sprintf("string containing %q", m.substring)
+}
+
+// Explain returns an explanation of why the value does not match the string containing matcher
+func (m *stringContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
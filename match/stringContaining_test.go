@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("stringContaining", func() {
@@ -13,7 +13,7 @@ var _ = Describe("stringContaining", func() {
 		It("returns a stringContaining struct", func() {
 			actual := StringContaining("")
 
-			Expect(actual).To(BeAssignableToTypeOf(new(stringContaining)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringContaining)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("stringContaining", func() {
 		It("returns all support kinds in go", func() {
 			actual := StringContaining("").SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.String: {},
 				}))
@@ -30,17 +30,19 @@ var _ = Describe("stringContaining", func() {
 
 	DescribeTable("Match returns true",
 		func(expected string, actual interface{}) {
-			Expect(StringContaining(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(StringContaining(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when the string contains the substring", "sub", "I have a substring"),
+		Entry("with a defined string type", "am", namedString("I am a string")),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected string, actual interface{}) {
-			Expect(StringContaining(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(StringContaining(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", "hi", nil),
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the substring does not exist in actual", "hello", "screams"),
+		Entry("when the substring does not exist in a defined string type", "hello", namedString("screams")),
 	)
 })
@@ -0,0 +1,43 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StringEqualFold returns a new matcher that will match strings and byte slices
+// that are equal to the provided string, ignoring case
+func StringEqualFold(expected string) SupportedKindsMatcher {
+	return &stringEqualFold{expected}
+}
+
+type stringEqualFold struct {
+	expected string
+}
+
+// SupportedKinds returns all the kinds the string equal fold matcher supports
+func (stringEqualFold) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match return true if the value is equal to the provided string under
+// Unicode case-folding; otherwise false
+func (m *stringEqualFold) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	if !ok {
+		return false
+	}
+
+	return strings.EqualFold(s, m.expected)
+}
+
+// String returns a description of what the string equal fold matcher expects
+func (m *stringEqualFold) String() string {
+	return fmt.Sprintf("string equal to %q ignoring case", m.expected)
+}
+
+// Explain returns an explanation of why the value does not match the string equal fold matcher
+func (m *stringEqualFold) Explain(value interface{}) string {
+	return explain(m, value)
+}
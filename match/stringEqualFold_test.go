@@ -0,0 +1,51 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("stringEqualFold", func() {
+	Describe("StringEqualFold", func() {
+		It("returns a stringEqualFold struct", func() {
+			actual := StringEqualFold("a")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringEqualFold)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := StringEqualFold("a").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+					reflect.Slice:  {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringEqualFold(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the strings are equal", "Mocka", "Mocka"),
+		Entry("when the strings differ in case", "Mocka", "mOCKA"),
+		Entry("when actual is a byte slice", "Mocka", []byte("MOCKA")),
+		Entry("when actual is a named string type", "Mocka", namedString("mocka")),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringEqualFold(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", "a", nil),
+		Entry("when actual is not a string", "a", 12),
+		Entry("when actual is a slice of a different type", "a", []int{1}),
+		Entry("when the strings are different", "Mocka", "Mock"),
+	)
+})
@@ -0,0 +1,44 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StringEqualIgnoringWhitespace returns a new matcher that will match strings
+// and byte slices that are equal to the provided string, ignoring leading and
+// trailing whitespace and treating any run of whitespace as a single space
+func StringEqualIgnoringWhitespace(expected string) SupportedKindsMatcher {
+	return &stringEqualIgnoringWhitespace{expected}
+}
+
+type stringEqualIgnoringWhitespace struct {
+	expected string
+}
+
+// SupportedKinds returns all the kinds the string equal ignoring whitespace matcher supports
+func (stringEqualIgnoringWhitespace) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match return true if the words of the value are equal to the words
+// of the provided string; otherwise false
+func (m *stringEqualIgnoringWhitespace) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	if !ok {
+		return false
+	}
+
+	return strings.Join(strings.Fields(s), " ") == strings.Join(strings.Fields(m.expected), " ")
+}
+
+// String returns a description of what the string equal ignoring whitespace matcher expects
+func (m *stringEqualIgnoringWhitespace) String() string {
+	return fmt.Sprintf("string equal to %q ignoring whitespace", m.expected)
+}
+
+// Explain returns an explanation of why the value does not match the string equal ignoring whitespace matcher
+func (m *stringEqualIgnoringWhitespace) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,52 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("stringEqualIgnoringWhitespace", func() {
+	Describe("StringEqualIgnoringWhitespace", func() {
+		It("returns a stringEqualIgnoringWhitespace struct", func() {
+			actual := StringEqualIgnoringWhitespace("a")
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringEqualIgnoringWhitespace)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := StringEqualIgnoringWhitespace("a").SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+					reflect.Slice:  {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringEqualIgnoringWhitespace(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the strings are equal", "SELECT * FROM users", "SELECT * FROM users"),
+		Entry("when the whitespace is different", "SELECT * FROM users", "\n\tSELECT *\n\t  FROM users\n"),
+		Entry("when actual is a byte slice", "SELECT * FROM users", []byte(" SELECT  *  FROM  users ")),
+		Entry("when actual is a named string type", "SELECT * FROM users", namedString("SELECT *\nFROM users")),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringEqualIgnoringWhitespace(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", "a", nil),
+		Entry("when actual is not a string", "a", 12),
+		Entry("when actual is a slice of a different type", "a", []int{1}),
+		Entry("when whitespace separates different words", "SELECT * FROM users", "SELECT * FROMusers"),
+		Entry("when the words are different", "SELECT * FROM users", "SELECT * FROM orders"),
+	)
+})
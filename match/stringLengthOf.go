@@ -0,0 +1,43 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// StringLengthOf returns a new matcher that will match strings and byte slices
+// with the provided number of characters. Unlike LengthOf the length is the
+// number of runes rather than the number of bytes.
+func StringLengthOf(length int) SupportedKindsMatcher {
+	return &stringLengthOf{length}
+}
+
+type stringLengthOf struct {
+	length int
+}
+
+// SupportedKinds returns all the kinds the string length of matcher supports
+func (stringLengthOf) SupportedKinds() map[reflect.Kind]struct{} {
+	return textKinds()
+}
+
+// Match return true if the number of runes matches the provided length; otherwise false
+func (m *stringLengthOf) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	if !ok {
+		return false
+	}
+
+	return utf8.RuneCountInString(s) == m.length
+}
+
+// String returns a description of what the string length of matcher expects
+func (m *stringLengthOf) String() string {
+	return fmt.Sprintf("string with length %v", m.length)
+}
+
+// Explain returns an explanation of why the value does not match the string length of matcher
+func (m *stringLengthOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
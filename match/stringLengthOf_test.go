@@ -0,0 +1,52 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("stringLengthOf", func() {
+	Describe("StringLengthOf", func() {
+		It("returns a stringLengthOf struct", func() {
+			actual := StringLengthOf(1)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringLengthOf)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := StringLengthOf(1).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+					reflect.Slice:  {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected int, actual interface{}) {
+			gomega.Expect(StringLengthOf(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the length matches", 5, "mocka"),
+		Entry("when the number of runes matches", 2, "日本"),
+		Entry("when actual is a byte slice", 5, []byte("mocka")),
+		Entry("when actual is a named string type", 5, namedString("mocka")),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected int, actual interface{}) {
+			gomega.Expect(StringLengthOf(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", 1, nil),
+		Entry("when actual is not a string", 1, 12),
+		Entry("when actual is a slice of a different type", 1, []int{1}),
+		Entry("when the length is different", 4, "mocka"),
+		Entry("when the number of bytes matches but not the number of runes", 6, "日本"),
+	)
+})
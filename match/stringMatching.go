@@ -0,0 +1,58 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+)
+
+// StringMatching returns a new matcher that will match strings and byte slices
+// using the provided regular expression. If the expression cannot be parsed
+// the matcher supports no kinds.
+func StringMatching(pattern string) SupportedKindsMatcher {
+	expression, err := regexp.Compile(pattern)
+	if err != nil {
+		return &stringMatching{pattern: pattern}
+	}
+
+	return &stringMatching{pattern: pattern, expression: expression}
+}
+
+type stringMatching struct {
+	pattern    string
+	expression *regexp.Regexp
+}
+
+// SupportedKinds returns all the kinds the string matching matcher supports;
+// an expression that cannot be parsed supports no kinds
+func (m *stringMatching) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.expression == nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return textKinds()
+}
+
+// Match return true if the regular expression matches the value; otherwise false
+func (m *stringMatching) Match(value interface{}) bool {
+	s, ok := textValue(value)
+	if !ok || m.expression == nil {
+		return false
+	}
+
+	return m.expression.MatchString(s)
+}
+
+// String returns a description of what the string matching matcher expects
+func (m *stringMatching) String() string {
+	if m.expression == nil {
+		return fmt.Sprintf("string matching invalid regular expression %q", m.pattern)
+	}
+
+	return fmt.Sprintf("string matching %q", m.pattern)
+}
+
+// Explain returns an explanation of why the value does not match the string matching matcher
+func (m *stringMatching) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,59 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("stringMatching", func() {
+	Describe("StringMatching", func() {
+		It("returns a stringMatching struct", func() {
+			actual := StringMatching(`^a+$`)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringMatching)))
+		})
+
+		It("does not panic when the expression is invalid", func() {
+			gomega.Expect(func() { StringMatching("(") }).ToNot(gomega.Panic())
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := StringMatching(`^a+$`).SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.String: {},
+					reflect.Slice:  {},
+				}))
+		})
+
+		It("returns no kinds when the expression is invalid", func() {
+			gomega.Expect(StringMatching("(").SupportedKinds()).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringMatching(expected).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when the expression matches a string", `^/users/\d+$`, "/users/12"),
+		Entry("when the expression matches a byte slice", `^/users/\d+$`, []byte("/users/12")),
+		Entry("when the expression matches a named string type", `^/users/\d+$`, namedString("/users/12")),
+	)
+
+	DescribeTable("Match returns false",
+		func(expected string, actual interface{}) {
+			gomega.Expect(StringMatching(expected).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", `^a+$`, nil),
+		Entry("when actual is not a string", `^a+$`, 12),
+		Entry("when actual is a slice of a different type", `^a+$`, []int{1}),
+		Entry("when the expression does not match", `^/users/\d+$`, "/users/me"),
+		Entry("when the expression is invalid", "(", "("),
+	)
+})
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -27,10 +28,20 @@ func (m *stringPrefix) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
+	switch v := reflect.ValueOf(value); v.Kind() {
 	case reflect.String:
-		return strings.HasPrefix(value.(string), m.prefix)
+		return strings.HasPrefix(v.String(), m.prefix)
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the string prefix matcher expects
+func (m *stringPrefix) String() string {
+	return fmt.Sprintf("string with prefix %q", m.prefix)
+}
+
+// Explain returns an explanation of why the value does not match the string prefix matcher
+func (m *stringPrefix) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("stringPrefix", func() {
@@ -13,7 +13,7 @@ var _ = Describe("stringPrefix", func() {
 		It("returns a stringPrefix struct", func() {
 			actual := StringPrefix("")
 
-			Expect(actual).To(BeAssignableToTypeOf(new(stringPrefix)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringPrefix)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("stringPrefix", func() {
 		It("returns all support kinds in go", func() {
 			actual := StringPrefix("").SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.String: {},
 				}))
@@ -30,17 +30,19 @@ var _ = Describe("stringPrefix", func() {
 
 	DescribeTable("Match returns true",
 		func(expected string, actual interface{}) {
-			Expect(StringPrefix(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(StringPrefix(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when the prefix is found", "I am", "I am a string"),
+		Entry("with a defined string type", "I am", namedString("I am a string")),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected string, actual interface{}) {
-			Expect(StringPrefix(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(StringPrefix(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", "hi", nil),
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the prefix does not exist in actual", "hello", "screams"),
+		Entry("when the prefix does not exist in a defined string type", "hello", namedString("screams")),
 	)
 })
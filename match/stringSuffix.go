@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -27,10 +28,20 @@ func (m *stringSuffix) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
+	switch v := reflect.ValueOf(value); v.Kind() {
 	case reflect.String:
-		return strings.HasSuffix(value.(string), m.suffix)
+		return strings.HasSuffix(v.String(), m.suffix)
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the string suffix matcher expects
+func (m *stringSuffix) String() string {
+	return fmt.Sprintf("string with suffix %q", m.suffix)
+}
+
+// Explain returns an explanation of why the value does not match the string suffix matcher
+func (m *stringSuffix) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("stringSuffix", func() {
@@ -13,7 +13,7 @@ var _ = Describe("stringSuffix", func() {
 		It("returns a stringSuffix struct", func() {
 			actual := StringSuffix("")
 
-			Expect(actual).To(BeAssignableToTypeOf(new(stringSuffix)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(stringSuffix)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("stringSuffix", func() {
 		It("returns all support kinds in go", func() {
 			actual := StringSuffix("").SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.String: {},
 				}))
@@ -30,17 +30,19 @@ var _ = Describe("stringSuffix", func() {
 
 	DescribeTable("Match returns true",
 		func(expected string, actual interface{}) {
-			Expect(StringSuffix(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(StringSuffix(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("when the string suffix is found", "a suffix", "I have a suffix"),
+		Entry("with a defined string type", "string", namedString("I am a string")),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected string, actual interface{}) {
-			Expect(StringSuffix(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(StringSuffix(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", "hi", nil),
 		Entry("when actual is not a string", "hi", 12),
 		Entry("when the suffix does not exist in actual", "hello", "screams"),
+		Entry("when the suffix does not exist in a defined string type", "hello", namedString("screams")),
 	)
 })
@@ -0,0 +1,37 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TimeAfter returns a new matcher that will match times after the provided time
+func TimeAfter(t time.Time) SupportedKindsMatcher {
+	return &timeAfter{t}
+}
+
+type timeAfter struct {
+	time time.Time
+}
+
+// SupportedKinds returns all the kinds the time after matcher supports
+func (timeAfter) SupportedKinds() map[reflect.Kind]struct{} {
+	return timeKinds()
+}
+
+// Match returns true if actual is a time after the provided time; otherwise false
+func (m *timeAfter) Match(value interface{}) bool {
+	t, ok := timeValue(value)
+	return ok && t.After(m.time)
+}
+
+// String returns a description of what the time after matcher expects
+func (m *timeAfter) String() string {
+	return fmt.Sprintf("time after %v", m.time)
+}
+
+// Explain returns an explanation of why the value does not match the time after matcher
+func (m *timeAfter) Explain(value interface{}) string {
+	return explain(m, value)
+}
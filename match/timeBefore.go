@@ -0,0 +1,37 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TimeBefore returns a new matcher that will match times before the provided time
+func TimeBefore(t time.Time) SupportedKindsMatcher {
+	return &timeBefore{t}
+}
+
+type timeBefore struct {
+	time time.Time
+}
+
+// SupportedKinds returns all the kinds the time before matcher supports
+func (timeBefore) SupportedKinds() map[reflect.Kind]struct{} {
+	return timeKinds()
+}
+
+// Match returns true if actual is a time before the provided time; otherwise false
+func (m *timeBefore) Match(value interface{}) bool {
+	t, ok := timeValue(value)
+	return ok && t.Before(m.time)
+}
+
+// String returns a description of what the time before matcher expects
+func (m *timeBefore) String() string {
+	return fmt.Sprintf("time before %v", m.time)
+}
+
+// Explain returns an explanation of why the value does not match the time before matcher
+func (m *timeBefore) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,51 @@
+package match
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("timeBefore", func() {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	Describe("TimeBefore", func() {
+		It("returns a timeBefore struct", func() {
+			actual := TimeBefore(now)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(timeBefore)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the time kinds", func() {
+			gomega.Expect(TimeBefore(now).SupportedKinds()).To(gomega.Equal(timeKinds()))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(actual interface{}) {
+			gomega.Expect(TimeBefore(now).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is before the time", now.Add(-time.Nanosecond)),
+		Entry("when actual is a pointer to a time before the time", timePointer(now.Add(-time.Hour))),
+		Entry("when actual is before the time in another location", now.Add(-time.Second).In(time.FixedZone("EST", -5*60*60))),
+	)
+
+	DescribeTable("Match returns false",
+		func(actual interface{}) {
+			gomega.Expect(TimeBefore(now).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is a nil time pointer", (*time.Time)(nil)),
+		Entry("when actual is not a time", now.Unix()-1),
+		Entry("when actual is the time", now),
+		Entry("when actual is after the time", now.Add(time.Nanosecond)),
+	)
+})
+
+func timePointer(t time.Time) *time.Time {
+	return &t
+}
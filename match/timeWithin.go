@@ -0,0 +1,46 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TimeWithin returns a new matcher that will match times within the provided
+// tolerance of the provided time. Unlike Exactly, times are compared without
+// their location or monotonic clock reading.
+func TimeWithin(t time.Time, tolerance time.Duration) SupportedKindsMatcher {
+	return &timeWithin{t.Round(0), tolerance}
+}
+
+type timeWithin struct {
+	time      time.Time
+	tolerance time.Duration
+}
+
+// SupportedKinds returns all the kinds the time within matcher supports
+func (timeWithin) SupportedKinds() map[reflect.Kind]struct{} {
+	return timeKinds()
+}
+
+// Match returns true if the difference between actual and the provided time
+// is less than or equal to the provided tolerance; otherwise false
+func (m *timeWithin) Match(value interface{}) bool {
+	t, ok := timeValue(value)
+	if !ok {
+		return false
+	}
+
+	t = t.Round(0)
+	return !t.Before(m.time.Add(-m.tolerance)) && !t.After(m.time.Add(m.tolerance))
+}
+
+// String returns a description of what the time within matcher expects
+func (m *timeWithin) String() string {
+	return fmt.Sprintf("time within %v of %v", m.tolerance, m.time)
+}
+
+// Explain returns an explanation of why the value does not match the time within matcher
+func (m *timeWithin) Explain(value interface{}) string {
+	return explain(m, value)
+}
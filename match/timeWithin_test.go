@@ -0,0 +1,60 @@
+package match
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("timeWithin", func() {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	Describe("TimeWithin", func() {
+		It("returns a timeWithin struct", func() {
+			actual := TimeWithin(now, time.Second)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(timeWithin)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the time kinds", func() {
+			gomega.Expect(TimeWithin(now, time.Second).SupportedKinds()).To(gomega.Equal(timeKinds()))
+		})
+	})
+
+	It("ignores the monotonic clock reading", func() {
+		current := time.Now()
+
+		gomega.Expect(TimeWithin(current.Round(0), 0).Match(current)).To(gomega.BeTrue())
+		gomega.Expect(TimeWithin(current, 0).Match(current.Round(0))).To(gomega.BeTrue())
+	})
+
+	It("does not describe the monotonic clock reading", func() {
+		gomega.Expect(TimeWithin(time.Now(), time.Second).(*timeWithin).String()).ToNot(gomega.ContainSubstring("m="))
+	})
+
+	DescribeTable("Match returns true",
+		func(actual interface{}) {
+			gomega.Expect(TimeWithin(now, time.Second).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is the time", now),
+		Entry("when actual is the tolerance before the time", now.Add(-time.Second)),
+		Entry("when actual is the tolerance after the time", now.Add(time.Second)),
+		Entry("when actual is a pointer to a time within the tolerance", timePointer(now.Add(time.Millisecond))),
+		Entry("when actual is the time in another location", now.In(time.FixedZone("EST", -5*60*60))),
+	)
+
+	DescribeTable("Match returns false",
+		func(actual interface{}) {
+			gomega.Expect(TimeWithin(now, time.Second).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", nil),
+		Entry("when actual is a nil time pointer", (*time.Time)(nil)),
+		Entry("when actual is not a time", now.Unix()),
+		Entry("when actual is more than the tolerance before the time", now.Add(-time.Second-time.Nanosecond)),
+		Entry("when actual is more than the tolerance after the time", now.Add(time.Second+time.Nanosecond)),
+	)
+})
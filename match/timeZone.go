@@ -0,0 +1,55 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TimeZone returns a new matcher that will match times in the provided location
+func TimeZone(location *time.Location) SupportedKindsMatcher {
+	return &timeZone{location}
+}
+
+type timeZone struct {
+	location *time.Location
+}
+
+// SupportedKinds returns all the kinds the time zone matcher supports;
+// no kinds are supported if the location is nil
+func (m *timeZone) SupportedKinds() map[reflect.Kind]struct{} {
+	if m.location == nil {
+		return map[reflect.Kind]struct{}{}
+	}
+
+	return timeKinds()
+}
+
+// Match returns true if the location of actual has the same
+// name as the provided location; otherwise false
+func (m *timeZone) Match(value interface{}) bool {
+	t, ok := timeValue(value)
+	if !ok || m.location == nil {
+		return false
+	}
+
+	return t.Location().String() == m.location.String()
+}
+
+// String returns a description of what the time zone matcher expects
+func (m *timeZone) String() string {
+	if m.location == nil {
+		return "time in location <nil>"
+	}
+
+	return fmt.Sprintf("time in location %q", m.location)
+}
+
+// Explain returns an explanation of why the value does not match the time zone matcher
+func (m *timeZone) Explain(value interface{}) string {
+	if t, ok := timeValue(value); ok {
+		return fmt.Sprintf("expected %v, got %v in location %q", m, t, t.Location())
+	}
+
+	return explain(m, value)
+}
@@ -0,0 +1,52 @@
+package match
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("timeZone", func() {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	est := time.FixedZone("EST", -5*60*60)
+
+	Describe("TimeZone", func() {
+		It("returns a timeZone struct", func() {
+			actual := TimeZone(time.UTC)
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(timeZone)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns the time kinds", func() {
+			gomega.Expect(TimeZone(time.UTC).SupportedKinds()).To(gomega.Equal(timeKinds()))
+		})
+
+		It("returns no kinds when the location is nil", func() {
+			gomega.Expect(TimeZone(nil).SupportedKinds()).To(gomega.BeEmpty())
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(location *time.Location, actual interface{}) {
+			gomega.Expect(TimeZone(location).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when actual is in the location", time.UTC, now),
+		Entry("when actual is a pointer to a time in the location", time.UTC, timePointer(now)),
+		Entry("when actual is in a location with the same name", time.FixedZone("EST", 0), now.In(est)),
+	)
+
+	DescribeTable("Match returns false",
+		func(location *time.Location, actual interface{}) {
+			gomega.Expect(TimeZone(location).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", time.UTC, nil),
+		Entry("when actual is a nil time pointer", time.UTC, (*time.Time)(nil)),
+		Entry("when actual is not a time", time.UTC, "UTC"),
+		Entry("when actual is in another location", time.UTC, now.In(est)),
+		Entry("when the location is nil", nil, now),
+	)
+})
@@ -67,3 +67,13 @@ func (m *typeOf) Match(value interface{}) bool {
 		return actualType.Name() == m.typeName
 	}
 }
+
+// String returns a description of what the type of matcher expects
+func (m *typeOf) String() string {
+	return fmt.Sprintf("value of type %v", m.typeName)
+}
+
+// Explain returns an explanation of why the value does not match the type of matcher
+func (m *typeOf) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("typeOf", func() {
@@ -13,7 +13,7 @@ var _ = Describe("typeOf", func() {
 		It("returns an typeOf struct", func() {
 			actual := TypeOf("int")
 
-			Expect(actual).To(BeAssignableToTypeOf(new(typeOf)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(typeOf)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("typeOf", func() {
 		It("returns all support kinds in go", func() {
 			actual := TypeOf("bool").SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Bool:          {},
 					reflect.Int:           {},
@@ -55,7 +55,7 @@ var _ = Describe("typeOf", func() {
 
 	DescribeTable("Match returns true when type names matches",
 		func(typeName string, value interface{}) {
-			Expect(TypeOf(typeName).Match(value)).To(BeTrue())
+			gomega.Expect(TypeOf(typeName).Match(value)).To(gomega.BeTrue())
 		},
 		Entry("with numbers", "int", 5),
 		Entry("with strings", "string", "hello"),
@@ -68,7 +68,7 @@ var _ = Describe("typeOf", func() {
 
 	DescribeTable("Match returns false",
 		func(typeName string, value interface{}) {
-			Expect(TypeOf(typeName).Match(value)).To(BeFalse())
+			gomega.Expect(TypeOf(typeName).Match(value)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", "int", nil),
 		Entry("when the type names do not match", "int", "i am an int"),
@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("uintGreaterThanOrEqualTo", func() {
@@ -13,7 +13,7 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 		It("returns an uintGreaterThanOrEqualTo struct", func() {
 			actual := UintGreaterThanOrEqualTo(uint64(10))
 
-			Expect(actual).To(BeAssignableToTypeOf(new(uintGreaterThanOrEqualTo)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(uintGreaterThanOrEqualTo)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 		It("returns all support kinds in go", func() {
 			actual := UintGreaterThanOrEqualTo(uint64(5)).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Uint:   {},
 					reflect.Uint8:  {},
@@ -34,7 +34,7 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 
 	DescribeTable("Match returns true",
 		func(expected uint64, actual interface{}) {
-			Expect(UintGreaterThanOrEqualTo(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(UintGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with uint", uint64(5), uint(10)),
 		Entry("with uint8", uint64(10), uint8(18)),
@@ -46,11 +46,12 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 		Entry("when actual(uint16) is the same as the expected", uint64(15), uint16(15)),
 		Entry("when actual(uint32) is the same as the expected", uint64(20), uint32(20)),
 		Entry("when actual(uint64) is the same as the expected", uint64(8), uint64(8)),
+		Entry("with a defined uint type", uint64(5), namedUint(5)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected uint64, actual interface{}) {
-			Expect(UintGreaterThanOrEqualTo(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(UintGreaterThanOrEqualTo(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", uint64(5), nil),
 		Entry("when actual(uint) is less than expected", uint64(5), uint(1)),
@@ -59,5 +60,7 @@ var _ = Describe("uintGreaterThanOrEqualTo", func() {
 		Entry("when actual(uint32) is less than expected", uint64(20), uint32(4)),
 		Entry("when actual(uint64) is less than expected", uint64(8), uint64(5)),
 		Entry("when actual is not an int", uint64(10), "10"),
+		Entry("when actual(defined uint type) is less than expected", uint64(5), namedUint(1)),
+		Entry("when expected is out of the range of uint8", uint64(300), uint8(50)),
 	)
 })
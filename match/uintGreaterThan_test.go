@@ -5,7 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
-	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega"
 )
 
 var _ = Describe("uintGreaterThan", func() {
@@ -13,7 +13,7 @@ var _ = Describe("uintGreaterThan", func() {
 		It("returns an uintGreaterThan struct", func() {
 			actual := UintGreaterThan(uint64(10))
 
-			Expect(actual).To(BeAssignableToTypeOf(new(uintGreaterThan)))
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(uintGreaterThan)))
 		})
 	})
 
@@ -21,7 +21,7 @@ var _ = Describe("uintGreaterThan", func() {
 		It("returns all support kinds in go", func() {
 			actual := UintGreaterThan(uint64(5)).SupportedKinds()
 
-			Expect(actual).To(Equal(
+			gomega.Expect(actual).To(gomega.Equal(
 				map[reflect.Kind]struct{}{
 					reflect.Uint:   {},
 					reflect.Uint8:  {},
@@ -34,18 +34,19 @@ var _ = Describe("uintGreaterThan", func() {
 
 	DescribeTable("Match returns true",
 		func(expected uint64, actual interface{}) {
-			Expect(UintGreaterThan(expected).Match(actual)).To(BeTrue())
+			gomega.Expect(UintGreaterThan(expected).Match(actual)).To(gomega.BeTrue())
 		},
 		Entry("with uint", uint64(5), uint(10)),
 		Entry("with uint8", uint64(10), uint8(18)),
 		Entry("with uint16", uint64(15), uint16(22)),
 		Entry("with uint32", uint64(20), uint32(40)),
 		Entry("with uint64", uint64(8), uint64(15)),
+		Entry("with a defined uint type", uint64(5), namedUint(10)),
 	)
 
 	DescribeTable("Match returns false",
 		func(expected uint64, actual interface{}) {
-			Expect(UintGreaterThan(expected).Match(actual)).To(BeFalse())
+			gomega.Expect(UintGreaterThan(expected).Match(actual)).To(gomega.BeFalse())
 		},
 		Entry("when actual is nil", uint64(5), nil),
 		Entry("when actual(uint) is less than expected", uint64(5), uint(1)),
@@ -59,5 +60,7 @@ var _ = Describe("uintGreaterThan", func() {
 		Entry("when actual(uint32) is the same as the expected", uint64(20), uint32(20)),
 		Entry("when actual(uint64) is the same as the expected", uint64(8), uint64(8)),
 		Entry("when actual is not an int", uint64(10), "10"),
+		Entry("when actual(defined uint type) is less than expected", uint64(5), namedUint(1)),
+		Entry("when expected is out of the range of uint8", uint64(300), uint8(50)),
 	)
 })
@@ -1,6 +1,7 @@
 package match
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -30,18 +31,20 @@ func (m *uintLessThanOrEqualTo) Match(value interface{}) bool {
 		return false
 	}
 
-	switch reflect.ValueOf(value).Kind() {
-	case reflect.Uint:
-		return value.(uint) <= uint(m.value)
-	case reflect.Uint8:
-		return value.(uint8) <= uint8(m.value)
-	case reflect.Uint16:
-		return value.(uint16) <= uint16(m.value)
-	case reflect.Uint32:
-		return value.(uint32) <= uint32(m.value)
-	case reflect.Uint64:
-		return value.(uint64) <= uint64(m.value)
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() <= m.value
 	default:
 		return false
 	}
 }
+
+// String returns a description of what the uint less than or equal to matcher expects
+func (m *uintLessThanOrEqualTo) String() string {
+	return fmt.Sprintf("uint less than or equal to %v", m.value)
+}
+
+// Explain returns an explanation of why the value does not match the uint less than or equal to matcher
+func (m *uintLessThanOrEqualTo) Explain(value interface{}) string {
+	return explain(m, value)
+}
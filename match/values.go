@@ -0,0 +1,488 @@
+package match
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// textKinds returns the kinds of the values that can be matched as text
+func textKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.String: {},
+		reflect.Slice:  {},
+	}
+}
+
+// textValue returns the value as a string and true if it is a string,
+// a named string type or a byte slice; otherwise false
+func textValue(value interface{}) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return "", false
+		}
+
+		return string(v.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// numericKinds returns the kinds of the values that can be matched as numbers
+func numericKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Int:     {},
+		reflect.Int8:    {},
+		reflect.Int16:   {},
+		reflect.Int32:   {},
+		reflect.Int64:   {},
+		reflect.Uint:    {},
+		reflect.Uint8:   {},
+		reflect.Uint16:  {},
+		reflect.Uint32:  {},
+		reflect.Uint64:  {},
+		reflect.Uintptr: {},
+		reflect.Float32: {},
+		reflect.Float64: {},
+	}
+}
+
+// number holds an int, uint or float value without losing precision
+type number struct {
+	kind reflect.Kind
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// numericValue returns the value as a number and true if it has
+// an int, uint or float kind; otherwise false
+func numericValue(value interface{}) (number, bool) {
+	if value == nil {
+		return number{}, false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: reflect.Int64, i: v.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: reflect.Uint64, u: v.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: v.Kind(), f: v.Float()}, true
+	default:
+		return number{}, false
+	}
+}
+
+// isFloat returns true if the number is a float32 or float64
+func (n number) isFloat() bool {
+	return n.kind == reflect.Float32 || n.kind == reflect.Float64
+}
+
+// float returns the number as a float64
+func (n number) float() float64 {
+	switch n.kind {
+	case reflect.Int64:
+		return float64(n.i)
+	case reflect.Uint64:
+		return float64(n.u)
+	default:
+		return n.f
+	}
+}
+
+// compare returns -1, 0 or 1 if the number is less than, equal to or greater
+// than the other number and true; it returns false if either number is NaN.
+// Floats are compared as float32 when either number is a float32, so that
+// float32 values are not compared against more precise float64 values. Ints
+// and uints are compared against floats exactly, without converting them.
+func (n number) compare(other number) (int, bool) {
+	if n.isFloat() && other.isFloat() {
+		a, b := n.f, other.f
+		if n.kind == reflect.Float32 || other.kind == reflect.Float32 {
+			a, b = float64(float32(a)), float64(float32(b))
+		}
+
+		switch {
+		case math.IsNaN(a) || math.IsNaN(b):
+			return 0, false
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	switch {
+	case n.isFloat():
+		if math.IsNaN(n.f) {
+			return 0, false
+		}
+
+		return -other.compareFloat(n.f), true
+	case other.isFloat():
+		if math.IsNaN(other.f) {
+			return 0, false
+		}
+
+		return n.compareFloat(other.f), true
+	case n.kind == reflect.Int64 && other.kind == reflect.Int64:
+		return compareInts(n.i, other.i), true
+	case n.kind == reflect.Uint64 && other.kind == reflect.Uint64:
+		return compareUints(n.u, other.u), true
+	case n.kind == reflect.Int64:
+		if n.i < 0 {
+			return -1, true
+		}
+
+		return compareUints(uint64(n.i), other.u), true
+	default:
+		if other.i < 0 {
+			return 1, true
+		}
+
+		return compareUints(n.u, uint64(other.i)), true
+	}
+}
+
+// compareFloat returns -1, 0 or 1 if the int or uint number is less than,
+// equal to or greater than the float, which must not be NaN. The integer part
+// of the float is compared exactly and its fraction decides between equal values.
+func (n number) compareFloat(f float64) int {
+	whole := math.Trunc(f)
+
+	var c int
+	if n.kind == reflect.Int64 {
+		switch {
+		case f >= 1<<63:
+			return -1
+		case f < -(1 << 63):
+			return 1
+		}
+
+		c = compareInts(n.i, int64(whole))
+	} else {
+		switch {
+		case f >= 1<<64:
+			return -1
+		case f < 0:
+			return 1
+		}
+
+		c = compareUints(n.u, uint64(whole))
+	}
+
+	switch {
+	case c != 0:
+		return c
+	case f > whole:
+		return -1
+	case f < whole:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareInts returns -1, 0 or 1 if a is less than, equal to or greater than b
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareUints returns -1, 0 or 1 if a is less than, equal to or greater than b
+func compareUints(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// matchesValue returns true if the actual value matches the expected value.
+// The expected value can be a matcher or a value that is compared using
+// reflect.DeepEqual.
+func matchesValue(expected interface{}, actual reflect.Value) bool {
+	if actual.Kind() == reflect.Interface && !actual.IsNil() {
+		actual = actual.Elem()
+	}
+
+	if matcher, ok := expected.(SupportedKindsMatcher); ok {
+		if _, ok := matcher.SupportedKinds()[actual.Kind()]; !ok {
+			return false
+		}
+
+		return matcher.Match(actual.Interface())
+	}
+
+	if expected == nil {
+		return isNillable(actual.Kind()) && actual.IsNil()
+	}
+
+	return reflect.DeepEqual(expected, actual.Interface())
+}
+
+// contextKinds returns the kinds of the values that can be matched as a context.Context
+func contextKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+	}
+}
+
+// contextValue returns the value as a context.Context and true if it
+// is a non-nil context.Context; otherwise false
+func contextValue(value interface{}) (context.Context, bool) {
+	ctx, ok := value.(context.Context)
+	if !ok || ctx == nil {
+		return nil, false
+	}
+
+	if v := reflect.ValueOf(ctx); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, false
+	}
+
+	return ctx, true
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// timeKinds returns the kinds of the values that can be matched as a time.Time
+func timeKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+		reflect.Struct:    {},
+	}
+}
+
+// timeValue returns the value as a time.Time and true if it is
+// a time.Time or a non-nil *time.Time; otherwise false
+func timeValue(value interface{}) (time.Time, bool) {
+	switch t := value.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, false
+		}
+
+		return *t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// jsonValue returns the value decoded from JSON text, with numbers decoded as
+// json.Number, and true if the value is text that contains a single valid JSON
+// value; otherwise false
+func jsonValue(value interface{}) (interface{}, bool) {
+	s, ok := textValue(value)
+	if !ok {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, false
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+
+	return decoded, true
+}
+
+// jsonNumber returns the JSON number as a number and true if it is valid;
+// otherwise false. Integers that fit in an int64 or uint64 are returned as
+// such, so they are compared exactly, and other numbers as the nearest float64.
+func jsonNumber(n json.Number) (number, bool) {
+	r, ok := new(big.Rat).SetString(string(n))
+	if !ok {
+		return number{}, false
+	}
+
+	if r.IsInt() {
+		switch i := r.Num(); {
+		case i.IsInt64():
+			return number{kind: reflect.Int64, i: i.Int64()}, true
+		case i.IsUint64():
+			return number{kind: reflect.Uint64, u: i.Uint64()}, true
+		}
+	}
+
+	f, _ := r.Float64()
+	return number{kind: reflect.Float64, f: f}, true
+}
+
+// jsonEqual returns true if the decoded JSON values are equal,
+// comparing their numbers exactly; otherwise false
+func jsonEqual(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+
+		for key, value := range e {
+			if v, ok := a[key]; !ok || !jsonEqual(value, v) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+
+		for i := range e {
+			if !jsonEqual(e[i], a[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		a, ok := actual.(json.Number)
+		if !ok {
+			return false
+		}
+
+		x, xOk := new(big.Rat).SetString(string(e))
+		y, yOk := new(big.Rat).SetString(string(a))
+		return xOk && yOk && x.Cmp(y) == 0
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// jsonFloats returns the decoded JSON value with its numbers
+// converted to float64, as they are decoded by json.Unmarshal
+func jsonFloats(decoded interface{}) interface{} {
+	switch d := decoded.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(d))
+		for key, value := range d {
+			converted[key] = jsonFloats(value)
+		}
+
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(d))
+		for i, value := range d {
+			converted[i] = jsonFloats(value)
+		}
+
+		return converted
+	case json.Number:
+		f, _ := d.Float64()
+		return f
+	default:
+		return decoded
+	}
+}
+
+// readerKinds returns the kinds of the values that can be matched as an io.Reader
+func readerKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Interface: {},
+		reflect.Ptr:       {},
+	}
+}
+
+// readerValue returns the unread content of the value and true if it is a
+// non-nil io.Reader that can be read without consuming it; otherwise false.
+// Readers with a Bytes method, such as *bytes.Buffer, are not read and
+// io.ReadSeekers are read and then seeked back to their original offset.
+// Readers that can be reset to read from another reader, such as *bufio.Reader,
+// and *io.LimitedReader are read fully and then replaced with a reader that
+// replays their content.
+func readerValue(value interface{}) (string, bool) {
+	if v := reflect.ValueOf(value); !v.IsValid() || (isNillable(v.Kind()) && v.IsNil()) {
+		return "", false
+	}
+
+	switch r := value.(type) {
+	case interface {
+		io.Reader
+		Bytes() []byte
+	}:
+		return string(r.Bytes()), true
+	case io.ReadSeeker:
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return "", false
+		}
+
+		content, err := io.ReadAll(r)
+		if _, seekErr := r.Seek(offset, io.SeekStart); err != nil || seekErr != nil {
+			return "", false
+		}
+
+		return string(content), true
+	case interface {
+		io.Reader
+		Reset(io.Reader)
+	}:
+		content, err := io.ReadAll(r)
+		r.Reset(replayReader(content, err))
+		return string(content), err == nil
+	case *io.LimitedReader:
+		content, err := io.ReadAll(r)
+		r.R, r.N = replayReader(content, err), int64(len(content))
+		return string(content), err == nil
+	default:
+		return "", false
+	}
+}
+
+// replayReader returns a reader of the content that returns the error,
+// if there is one, once the content has been read
+func replayReader(content []byte, err error) io.Reader {
+	if err == nil {
+		return bytes.NewReader(content)
+	}
+
+	return io.MultiReader(bytes.NewReader(content), errorReader{err})
+}
+
+// errorReader is an io.Reader that returns its error for every read
+type errorReader struct {
+	err error
+}
+
+// Read returns the error of the reader
+func (r errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,61 @@
+package match
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ValuesContaining returns a new matcher that will match maps containing
+// each of the provided values. The values can be matchers or values that are
+// compared using reflect.DeepEqual.
+func ValuesContaining(values ...interface{}) SupportedKindsMatcher {
+	return &valuesContaining{values}
+}
+
+type valuesContaining struct {
+	values []interface{}
+}
+
+// SupportedKinds returns all the kinds the values containing matcher supports
+func (valuesContaining) SupportedKinds() map[reflect.Kind]struct{} {
+	return map[reflect.Kind]struct{}{
+		reflect.Map: {},
+	}
+}
+
+// Match return true if each of the provided values matches
+// at least one value in the map; otherwise false
+func (m *valuesContaining) Match(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map:
+		for _, expected := range m.values {
+			var found bool
+			iter := v.MapRange()
+			for !found && iter.Next() {
+				found = matchesValue(expected, iter.Value())
+			}
+
+			if !found {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a description of what the values containing matcher expects
+func (m *valuesContaining) String() string {
+	return fmt.Sprintf("map with values containing (%v)", describeValues(m.values))
+}
+
+// Explain returns an explanation of why the value does not match the values containing matcher
+func (m *valuesContaining) Explain(value interface{}) string {
+	return explain(m, value)
+}
@@ -0,0 +1,56 @@
+package match
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("valuesContaining", func() {
+	Describe("ValuesContaining", func() {
+		It("returns a valuesContaining struct", func() {
+			actual := ValuesContaining()
+
+			gomega.Expect(actual).To(gomega.BeAssignableToTypeOf(new(valuesContaining)))
+		})
+	})
+
+	Describe("SupportedKinds", func() {
+		It("returns all support kinds in go", func() {
+			actual := ValuesContaining().SupportedKinds()
+
+			gomega.Expect(actual).To(gomega.Equal(
+				map[reflect.Kind]struct{}{
+					reflect.Map: {},
+				}))
+		})
+	})
+
+	DescribeTable("Match returns true",
+		func(values []interface{}, actual interface{}) {
+			gomega.Expect(ValuesContaining(values...).Match(actual)).To(gomega.BeTrue())
+		},
+		Entry("when there are no values", []interface{}{}, map[string]int{}),
+		Entry("when the map contains the values", []interface{}{1, 2}, map[string]int{"a": 1, "b": 2, "c": 3}),
+		Entry("when the values match the matchers", []interface{}{GreaterThan(2)}, map[string]int{"a": 1, "b": 2, "c": 3}),
+		Entry("when a nested map is equal", []interface{}{map[string]interface{}{"id": 1}}, map[string]interface{}{
+			"first": map[string]interface{}{"id": 1},
+		}),
+	)
+
+	DescribeTable("Match returns false",
+		func(values []interface{}, actual interface{}) {
+			gomega.Expect(ValuesContaining(values...).Match(actual)).To(gomega.BeFalse())
+		},
+		Entry("when actual is nil", []interface{}{}, nil),
+		Entry("when actual is not a map", []interface{}{1}, []int{1}),
+		Entry("when the map does not contain a value", []interface{}{1, 4}, map[string]int{"a": 1, "b": 2}),
+		Entry("when no value matches the matcher", []interface{}{GreaterThan(2)}, map[string]int{"a": 1, "b": 2}),
+		Entry("when the value has a different type", []interface{}{int64(1)}, map[string]int{"a": 1}),
+		Entry("when a nested map is only a subset", []interface{}{map[string]interface{}{"id": 1}}, map[string]interface{}{
+			"first": map[string]interface{}{"id": 1, "name": "mocka"},
+		}),
+	)
+})
@@ -0,0 +1,99 @@
+package match
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	"github.com/onsi/gomega"
+)
+
+type (
+	namedString string
+	namedInt    int64
+	namedUint   uint16
+	namedFloat  float32
+)
+
+var _ = Describe("values", func() {
+	DescribeTable("textValue returns the text",
+		func(value interface{}, expected string) {
+			actual, ok := textValue(value)
+
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(actual).To(gomega.Equal(expected))
+		},
+		Entry("for a string", "mocka", "mocka"),
+		Entry("for a named string type", namedString("mocka"), "mocka"),
+		Entry("for a byte slice", []byte("mocka"), "mocka"),
+	)
+
+	DescribeTable("textValue returns false",
+		func(value interface{}) {
+			_, ok := textValue(value)
+
+			gomega.Expect(ok).To(gomega.BeFalse())
+		},
+		Entry("for nil", nil),
+		Entry("for an int", 1),
+		Entry("for a slice of ints", []int{1}),
+	)
+
+	DescribeTable("jsonNumber returns the number",
+		func(n json.Number, expected number) {
+			actual, ok := jsonNumber(n)
+
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(actual).To(gomega.Equal(expected))
+		},
+		Entry("for an int beyond the precision of a float64", json.Number("12345678901234567"), number{kind: reflect.Int64, i: 12345678901234567}),
+		Entry("for an int written with a fraction", json.Number("-7.0"), number{kind: reflect.Int64, i: -7}),
+		Entry("for an int written with an exponent", json.Number("1e2"), number{kind: reflect.Int64, i: 100}),
+		Entry("for a uint beyond the range of an int64", json.Number("18446744073709551615"), number{kind: reflect.Uint64, u: math.MaxUint64}),
+		Entry("for a float", json.Number("0.5"), number{kind: reflect.Float64, f: 0.5}),
+		Entry("for an int beyond the range of a uint64", json.Number("18446744073709551616"), number{kind: reflect.Float64, f: 1 << 64}),
+	)
+
+	It("jsonNumber returns false for an invalid number", func() {
+		_, ok := jsonNumber(json.Number("mocka"))
+
+		gomega.Expect(ok).To(gomega.BeFalse())
+	})
+
+	DescribeTable("number.compare compares ints and uints against floats exactly",
+		func(value interface{}, other interface{}, expected int) {
+			n, _ := numericValue(value)
+			o, _ := numericValue(other)
+
+			actual, ok := n.compare(o)
+
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(actual).To(gomega.Equal(expected))
+		},
+		Entry("for an int above a float32 it would round to", 16777217, float32(16777216), 1),
+		Entry("for an int equal to a float32", 16777216, float32(16777216), 0),
+		Entry("for a float64 above an int it would round to", float64(1<<63), int64(math.MaxInt64), 1),
+		Entry("for an int below a float with a fraction", 2, 2.5, -1),
+		Entry("for a negative int above a float with a fraction", -2, -2.5, 1),
+		Entry("for zero above a negative fraction", 0, -0.5, 1),
+		Entry("for a uint equal to a float", uint64(1<<53+2), float64(1<<53+2), 0),
+		Entry("for a uint above a float it would round to", uint64(1<<53+1), float64(1<<53), 1),
+		Entry("for the max uint below a float beyond its range", uint64(math.MaxUint64), float64(1<<64), -1),
+		Entry("for a uint above a negative float", uint64(0), -0.5, 1),
+		Entry("for an int below positive infinity", int64(math.MaxInt64), math.Inf(1), -1),
+		Entry("for an int above negative infinity", int64(math.MinInt64), math.Inf(-1), 1),
+	)
+
+	It("number.compare does not compare against NaN", func() {
+		n, _ := numericValue(1)
+		nan, _ := numericValue(math.NaN())
+
+		_, ok := n.compare(nan)
+		gomega.Expect(ok).To(gomega.BeFalse())
+
+		_, ok = nan.compare(n)
+		gomega.Expect(ok).To(gomega.BeFalse())
+	})
+})
@@ -1,10 +1,18 @@
 package mocka
 
+import "time"
+
+// lastCallIndex is used as the upper bound for open ended call ranges
+const lastCallIndex = int(^uint(0) >> 1)
+
 // OnCall describes the functionality to set custom return value based on call index
 type OnCall struct {
-	stub  *Stub
-	index int
-	out   []interface{}
+	stub      *Stub
+	index     int
+	lastIndex int
+	out       []interface{}
+	wait      waiter
+	sequence  *Sequence
 }
 
 // Return sets the return values for this set of custom arguments
@@ -18,4 +26,99 @@ func (c *OnCall) Return(returnValues ...interface{}) {
 	}
 
 	c.out = returnValues
+	c.sequence = nil
+}
+
+// Delay makes the stub sleep for the provided duration before returning
+// for the call indexes of the OnCall
+func (c *OnCall) Delay(d time.Duration) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	c.wait = delay(d)
+}
+
+// BlockUntil makes the stub block until the provided channel is closed
+// or receives a value before returning for the call indexes of the OnCall
+func (c *OnCall) BlockUntil(ch <-chan struct{}) {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	c.wait = blockUntil(ch)
+}
+
+// BlockUntilContextDone makes the stub block until the first context.Context
+// argument is done for the call indexes of the OnCall. The context's error
+// will be returned as the error out parameter, if the function returns an error.
+func (c *OnCall) BlockUntilContextDone() {
+	c.stub.lock.Lock()
+	defer c.stub.lock.Unlock()
+
+	if !hasContextArgument(c.stub.toType()) {
+		reportMissingContextArgument(c.stub.testReporter, c.stub.toType())
+		return
+	}
+
+	c.wait = blockUntilContextDone()
+}
+
+// covers returns true if the call index falls within the call indexes of the OnCall
+func (c *OnCall) covers(callIndex int) bool {
+	return callIndex == c.index || (callIndex > c.index && callIndex <= c.lastIndex)
+}
+
+// span returns the number of additional call indexes covered after the first index
+func (c *OnCall) span() int {
+	if c.lastIndex <= c.index {
+		return 0
+	}
+
+	return c.lastIndex - c.index
+}
+
+// getOrAddOnCall returns the OnCall for the provided call indexes if it exists;
+// otherwise a new OnCall is created and added to the slice of OnCalls
+func getOrAddOnCall(stub *Stub, onCalls *[]*OnCall, index int, lastIndex int) *OnCall {
+	if lastIndex <= index {
+		lastIndex = 0
+	}
+
+	for _, o := range *onCalls {
+		if o.index == index && o.lastIndex == lastIndex {
+			return o
+		}
+	}
+
+	o := &OnCall{index: index, lastIndex: lastIndex, stub: stub}
+	*onCalls = append(*onCalls, o)
+	return o
+}
+
+// findOnCall returns the most specific OnCall with return values that covers
+// the provided call index; otherwise nil
+func findOnCall(onCalls []*OnCall, callIndex int) *OnCall {
+	return findCoveringOnCall(onCalls, callIndex, func(o *OnCall) bool { return o.out != nil })
+}
+
+// findOnCallWaiter returns the most specific OnCall with a waiter that covers
+// the provided call index; otherwise nil
+func findOnCallWaiter(onCalls []*OnCall, callIndex int) *OnCall {
+	return findCoveringOnCall(onCalls, callIndex, func(o *OnCall) bool { return o.wait != nil })
+}
+
+// findCoveringOnCall returns the most specific OnCall that covers the provided
+// call index and has the required value set; otherwise nil
+func findCoveringOnCall(onCalls []*OnCall, callIndex int, isSet func(*OnCall) bool) *OnCall {
+	var found *OnCall
+	for _, o := range onCalls {
+		if !isSet(o) || !o.covers(callIndex) {
+			continue
+		}
+
+		if found == nil || o.span() < found.span() {
+			found = o
+		}
+	}
+
+	return found
 }
@@ -1,6 +1,8 @@
 package mocka
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -52,4 +54,75 @@ var _ = Describe("OnCall", func() {
 			Expect(ca.out).To(Equal([]interface{}{42, nil}))
 		})
 	})
+
+	Describe("Delay", func() {
+		It("only delays the calls covered by the OnCall", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.OnCall(1).Delay(20 * time.Millisecond)
+
+			start := time.Now()
+			_, _ = fn("", 0)
+			Expect(time.Since(start)).To(BeNumerically("<", 20*time.Millisecond))
+
+			start = time.Now()
+			_, _ = fn("", 0)
+			Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+		})
+	})
+
+	Describe("BlockUntil", func() {
+		It("assigns the waiter", func() {
+			c := &OnCall{stub: stub}
+
+			c.BlockUntil(make(chan struct{}))
+
+			Expect(c.wait).ToNot(BeNil())
+		})
+	})
+
+	Describe("BlockUntilContextDone", func() {
+		It("reports an error if the function does not have a context argument", func() {
+			stub.testReporter = failTestReporter
+			c := &OnCall{stub: stub}
+
+			c.BlockUntilContextDone()
+
+			Expect(c.wait).To(BeNil())
+			Expect(failTestReporter.messages).To(HaveLen(1))
+		})
+	})
+
+	Describe("findOnCall", func() {
+		It("returns nil if no onCall covers the call index", func() {
+			onCalls := []*OnCall{
+				{stub: stub, index: 0, out: []interface{}{1, nil}},
+				{stub: stub, index: 2, lastIndex: 4, out: []interface{}{2, nil}},
+			}
+
+			Expect(findOnCall(onCalls, 5)).To(BeNil())
+		})
+
+		It("ignores onCalls without return values", func() {
+			onCalls := []*OnCall{
+				{stub: stub, index: 3},
+			}
+
+			Expect(findOnCall(onCalls, 3)).To(BeNil())
+		})
+
+		It("returns the most specific onCall that covers the call index", func() {
+			expected := &OnCall{stub: stub, index: 3, out: []interface{}{3, nil}}
+			onCalls := []*OnCall{
+				{stub: stub, index: 1, lastIndex: lastCallIndex, out: []interface{}{1, nil}},
+				{stub: stub, index: 2, lastIndex: 4, out: []interface{}{2, nil}},
+				expected,
+			}
+
+			Expect(findOnCall(onCalls, 3)).To(BeIdenticalTo(expected))
+			Expect(findOnCall(onCalls, 4)).To(BeIdenticalTo(onCalls[1]))
+			Expect(findOnCall(onCalls, 100)).To(BeIdenticalTo(onCalls[0]))
+		})
+	})
 })
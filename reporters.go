@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/Bayer-Group/mocka/v2/match"
 )
 
 // reportInvalidArguments reports invalid argument to fail the test
@@ -18,7 +20,21 @@ func reportInvalidArguments(testReporter TestReporter, functionType reflect.Type
 		realArgTypes[i] = toFriendlyName(functionType.In(i))
 	}
 
-	testReporter.Errorf("mocka: expected arguments of type (%v), but received (%v)", strings.Join(realArgTypes, ", "), strings.Join(mapToTypeName(arguments), ", "))
+	message := fmt.Sprintf("mocka: expected arguments of type (%v), but received (%v)", strings.Join(realArgTypes, ", "), strings.Join(mapToArgumentName(arguments), ", "))
+	for i, arg := range arguments {
+		m, ok := arg.(match.SupportedKindsMatcher)
+		if !ok {
+			continue
+		}
+
+		if t, ok := argumentType(functionType, i); ok {
+			if _, supported := m.SupportedKinds()[t.Kind()]; !supported {
+				message += fmt.Sprintf("\n\targ %v: %v does not support arguments of kind %v", i+1, match.Description(m), t.Kind())
+			}
+		}
+	}
+
+	testReporter.Errorf("%v", message)
 }
 
 // reportInvalidOutParameters reports invalid out parameters to fail the test
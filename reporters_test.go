@@ -3,6 +3,7 @@ package mocka
 import (
 	"reflect"
 
+	"github.com/Bayer-Group/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -44,6 +45,32 @@ var _ = Describe("reporters", func() {
 			Expect(reporter.messages).To(HaveLen(1))
 			Expect(reporter.messages).To(ContainElement("mocka: expected arguments of type (string, ...string), but received (int, int)"))
 		})
+
+		It("reports the description of matchers and the arguments whose kind they do not support", func() {
+			arguments := []interface{}{match.StringPrefix("/api"), match.StringSuffix("s")}
+
+			reportInvalidArguments(reporter, functionType, arguments)
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, int), but received (string with prefix \"/api\", string with suffix \"s\")\n" +
+					"\targ 2: string with suffix \"s\" does not support arguments of kind int",
+			}))
+		})
+
+		It("reports the variadic arguments whose kind a matcher does not support", func() {
+			arguments := []interface{}{"a", "b", match.IntGreaterThan(1)}
+			var fn = func(str string, opts ...string) int {
+				return len(str) + len(opts)
+			}
+			functionType = reflect.ValueOf(&fn).Elem().Type()
+
+			reportInvalidArguments(reporter, functionType, arguments)
+
+			Expect(reporter.messages).To(Equal([]string{
+				"mocka: expected arguments of type (string, ...string), but received (string, string, int greater than 1)\n" +
+					"\targ 3: int greater than 1 does not support arguments of kind string",
+			}))
+		})
 	})
 
 	Describe("reportInvalidOutParameters", func() {
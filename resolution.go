@@ -0,0 +1,120 @@
+package mocka
+
+import "github.com/Bayer-Group/mocka/v2/match"
+
+// ReturnSource describes where the return values of a call came from
+type ReturnSource int
+
+const (
+	// DefaultReturn the default return values of the stub
+	DefaultReturn ReturnSource = iota
+	// FuzzReturn the return values decoded from the fuzz input of the stub
+	FuzzReturn
+	// OnCallReturn the return values of an OnCall of the stub, including ReturnsInOrder
+	OnCallReturn
+	// SequenceReturn the return values of an exhausted ReturnsInOrder sequence of the stub
+	SequenceReturn
+	// WithArgsReturn the return values of a WithArgs rule
+	WithArgsReturn
+	// WithArgsOnCallReturn the return values of an OnCall of a WithArgs rule, including ReturnsInOrder
+	WithArgsOnCallReturn
+	// WithArgsSequenceReturn the return values of an exhausted ReturnsInOrder sequence of a WithArgs rule
+	WithArgsSequenceReturn
+	// FaultReturn the error injected by InjectFaults, which replaced the error return value
+	FaultReturn
+	// ContextDoneReturn the error of a done context.Context returned by BlockUntilContextDone,
+	// which replaced the error return value
+	ContextDoneReturn
+)
+
+// String returns the name of the return source
+func (s ReturnSource) String() string {
+	switch s {
+	case DefaultReturn:
+		return "default"
+	case FuzzReturn:
+		return "fuzz"
+	case OnCallReturn:
+		return "OnCall"
+	case SequenceReturn:
+		return "ReturnsInOrder"
+	case WithArgsReturn:
+		return "WithArgs"
+	case WithArgsOnCallReturn:
+		return "WithArgs OnCall"
+	case WithArgsSequenceReturn:
+		return "WithArgs ReturnsInOrder"
+	case FaultReturn:
+		return "InjectFaults"
+	case ContextDoneReturn:
+		return "BlockUntilContextDone"
+	default:
+		return "unknown"
+	}
+}
+
+// fromWithArgs returns true if the return values came from a WithArgs rule
+func (s ReturnSource) fromWithArgs() bool {
+	return s == WithArgsReturn || s == WithArgsOnCallReturn || s == WithArgsSequenceReturn
+}
+
+// Resolution describes how the return values of a call were resolved
+type Resolution struct {
+	// Source is where the return values came from
+	Source ReturnSource
+	// Rule is the zero-based index of the WithArgs rule selected for the call;
+	// otherwise -1 if no rule matched the arguments
+	Rule int
+	// MatchedRules are the WithArgs rules that matched the arguments, with the
+	// priorities used to select between them
+	MatchedRules []MatchedRule
+}
+
+// MatchedRule describes a WithArgs rule that matched the arguments of a call
+type MatchedRule struct {
+	// Rule is the zero-based index of the WithArgs rule
+	Rule int
+	// Priorities are the priorities of the matchers of the rule by argument position
+	Priorities []float64
+}
+
+// rules returns the WithArgs rules of the stub, skipping the custom
+// arguments that were not valid
+func (stub *Stub) rules() []*CustomArguments {
+	var rules []*CustomArguments
+	for _, ca := range stub.customArgs {
+		if ca != nil {
+			rules = append(rules, ca)
+		}
+	}
+
+	return rules
+}
+
+// ruleIndex returns the index of the custom arguments in the WithArgs
+// rules of the stub; otherwise -1
+func (stub *Stub) ruleIndex(customArgs *CustomArguments) int {
+	for i, ca := range stub.rules() {
+		if ca == customArgs {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// matchedRules maps the custom arguments that matched a call to
+// their rule indexes and priorities
+func (stub *Stub) matchedRules(possible []*CustomArguments) []MatchedRule {
+	var matched []MatchedRule
+	for _, ca := range possible {
+		priorities := make([]float64, len(ca.argMatchers))
+		for i, m := range ca.argMatchers {
+			priorities[i] = match.Priority(m)
+		}
+
+		matched = append(matched, MatchedRule{Rule: stub.ruleIndex(ca), Priorities: priorities})
+	}
+
+	return matched
+}
@@ -0,0 +1,177 @@
+package mocka
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bayer-Group/mocka/v2/match"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resolution", func() {
+	var (
+		fn   func(string, int) (int, error)
+		stub *Stub
+	)
+
+	BeforeEach(func() {
+		fn = func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+
+		stub = newStub(GinkgoT(), &fn, []interface{}{42, nil})
+	})
+
+	AfterEach(func() {
+		stub.Restore()
+	})
+
+	It("records the default return values", func() {
+		stub.WithArgs("sam", 1).Return(1, nil)
+
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution()).To(Equal(Resolution{Source: DefaultReturn, Rule: -1}))
+	})
+
+	It("records the fuzz return values", func() {
+		FuzzReturns(stub, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution()).To(Equal(Resolution{Source: FuzzReturn, Rule: -1}))
+	})
+
+	It("records the return values of an OnCall of the stub", func() {
+		stub.OnSecondCall().Return(2, nil)
+
+		_, _ = fn("hello", 1)
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution().Source).To(Equal(DefaultReturn))
+		Expect(stub.GetCall(1).Resolution().Source).To(Equal(OnCallReturn))
+	})
+
+	It("records the return values of an exhausted sequence of the stub", func() {
+		stub.ReturnsInOrder([]interface{}{1, nil}).WhenExhausted(RepeatLast)
+
+		_, _ = fn("hello", 1)
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution().Source).To(Equal(OnCallReturn))
+		Expect(stub.GetCall(1).Resolution().Source).To(Equal(SequenceReturn))
+	})
+
+	It("records the WithArgs rule and the priorities of the matching rules", func() {
+		stub.WithArgs("sam", 1).Return(1, nil)
+		stub.WithArgs(match.Anything(), 1).Return(2, nil)
+		stub.WithArgs("hello", match.Anything()).Return(3, nil)
+
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution()).To(Equal(Resolution{
+			Source: WithArgsReturn,
+			Rule:   2,
+			MatchedRules: []MatchedRule{
+				{Rule: 1, Priorities: []float64{match.Priority(match.Anything()), match.Priority(match.Exactly(1))}},
+				{Rule: 2, Priorities: []float64{match.Priority(match.Exactly("hello")), match.Priority(match.Anything())}},
+			},
+		}))
+	})
+
+	It("records the WithArgs rule when the rule does not change the return values", func() {
+		stub.OnFirstCall().Return(1, nil)
+		_ = stub.WithArgs("hello", 1)
+
+		_, _ = fn("hello", 1)
+
+		resolution := stub.GetCall(0).Resolution()
+		Expect(resolution.Source).To(Equal(OnCallReturn))
+		Expect(resolution.Rule).To(Equal(0))
+	})
+
+	It("records the return values of an OnCall of a WithArgs rule", func() {
+		stub.WithArgs("hello", 1).OnSecondCall().Return(2, nil)
+
+		_, _ = fn("hello", 1)
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution().Source).To(Equal(DefaultReturn))
+		Expect(stub.GetCall(1).Resolution().Source).To(Equal(WithArgsOnCallReturn))
+		Expect(stub.GetCall(1).Resolution().Rule).To(Equal(0))
+	})
+
+	It("records the return values of an exhausted sequence of a WithArgs rule", func() {
+		stub.WithArgs("hello", 1).ReturnsInOrder([]interface{}{1, nil}).WhenExhausted(Cycle)
+
+		_, _ = fn("hello", 1)
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution().Source).To(Equal(WithArgsOnCallReturn))
+		Expect(stub.GetCall(1).Resolution().Source).To(Equal(WithArgsSequenceReturn))
+	})
+
+	It("records the errors injected by InjectFaults", func() {
+		stub.WithArgs("hello", 1).Return(1, nil)
+		stub.InjectFaults(FailEvery(2, errors.New("ope")))
+
+		_, _ = fn("hello", 1)
+		_, _ = fn("hello", 1)
+
+		Expect(stub.GetCall(0).Resolution().Source).To(Equal(WithArgsReturn))
+		Expect(stub.GetCall(1).Resolution().Source).To(Equal(FaultReturn))
+		Expect(stub.GetCall(1).Resolution().Rule).To(Equal(0))
+	})
+
+	It("records the errors of a done context returned by BlockUntilContextDone", func() {
+		ctxFn := func(context.Context, string) (int, error) { return 0, nil }
+		ctxStub := newStub(GinkgoT(), &ctxFn, []interface{}{42, nil})
+		defer ctxStub.Restore()
+		ctxStub.WithArgs(match.Anything(), "hello").BlockUntilContextDone()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _ = ctxFn(ctx, "hello")
+		_, _ = ctxFn(nil, "hello")
+
+		Expect(ctxStub.GetCall(0).Resolution()).To(Equal(Resolution{
+			Source: ContextDoneReturn,
+			Rule:   0,
+			MatchedRules: []MatchedRule{
+				{Rule: 0, Priorities: []float64{match.Priority(match.Anything()), match.Priority(match.Exactly("hello"))}},
+			},
+		}))
+		Expect(ctxStub.GetCall(1).Resolution().Source).To(Equal(DefaultReturn))
+	})
+
+	It("indexes the WithArgs rules without the rules that were not valid", func() {
+		failTestReporter := &mockTestReporter{}
+		stub.testReporter = failTestReporter
+		_ = stub.WithArgs("hello")
+		stub.testReporter = GinkgoT()
+		stub.WithArgs("hello", 1).Return(1, nil)
+
+		_, _ = fn("hello", 1)
+
+		Expect(failTestReporter.messages).To(HaveLen(1))
+		Expect(stub.GetCall(0).Resolution().Rule).To(Equal(0))
+	})
+
+	DescribeTable("ReturnSource.String",
+		func(source ReturnSource, expected string) {
+			Expect(source.String()).To(Equal(expected))
+		},
+		Entry("default", DefaultReturn, "default"),
+		Entry("fuzz", FuzzReturn, "fuzz"),
+		Entry("OnCall", OnCallReturn, "OnCall"),
+		Entry("ReturnsInOrder", SequenceReturn, "ReturnsInOrder"),
+		Entry("WithArgs", WithArgsReturn, "WithArgs"),
+		Entry("WithArgs OnCall", WithArgsOnCallReturn, "WithArgs OnCall"),
+		Entry("WithArgs ReturnsInOrder", WithArgsSequenceReturn, "WithArgs ReturnsInOrder"),
+		Entry("InjectFaults", FaultReturn, "InjectFaults"),
+		Entry("BlockUntilContextDone", ContextDoneReturn, "BlockUntilContextDone"),
+		Entry("unknown", ReturnSource(-1), "unknown"),
+	)
+})
@@ -8,6 +8,10 @@ type Sandbox struct {
 
 	testReporter TestReporter
 	stubs        []*Stub
+	streams      []int64
+	created      int64
+	faults       *FaultPolicy
+	fuzzInput    []byte
 }
 
 // Function replaces the provided function with a stubbed implementation. The
@@ -22,13 +26,41 @@ func (s *Sandbox) Function(originalFuncPtr interface{}, returnValues ...interfac
 	defer s.lock.Unlock()
 
 	stub := newStub(s.testReporter, originalFuncPtr, returnValues)
+	stream := s.created
+	s.created++
 	s.stubs = append(s.stubs, stub)
+	s.streams = append(s.streams, stream)
+
+	if stub != nil && s.faults != nil && errorOutIndex(stub.toType()) != -1 {
+		stub.InjectFaults(s.faults.withStream(stream))
+	}
+
+	if s.fuzzInput != nil {
+		FuzzReturns(stub, s.fuzzInput)
+	}
 
 	return stub
 }
 
+// InjectFaults applies the fault policy to every stub in the sandbox whose last
+// return value is an error. This includes stubs created after the call, until
+// the sandbox is restored. Each stub fails on its own calls, derived from the
+// seed of the policy and the order the stub was created in the sandbox.
+func (s *Sandbox) InjectFaults(policy FaultPolicy) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	policy = policy.withSeed()
+	s.faults = &policy
+	for i, stub := range s.stubs {
+		if stub != nil && errorOutIndex(stub.toType()) != -1 {
+			stub.InjectFaults(policy.withStream(s.streams[i]))
+		}
+	}
+}
+
 // Restore restores all the function stubs that were created via this sandbox to
-// the original functionality they once held.
+// the original functionality they once held. Any fault policy is removed.
 func (s *Sandbox) Restore() {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -41,4 +73,6 @@ func (s *Sandbox) Restore() {
 
 	// clears out the slice to prevent a memory leak.
 	s.stubs = nil
+	s.streams = nil
+	s.faults = nil
 }
@@ -140,6 +140,50 @@ var _ = Describe("sandbox", func() {
 		})
 	})
 
+	Describe("InjectFaults", func() {
+		AfterEach(func() {
+			testSandbox.Restore()
+		})
+
+		It("injects faults into every stub returning an error", func() {
+			_ = testSandbox.Function(&fn1, 42, nil)
+			fn2Stub := testSandbox.Function(&fn2, 42)
+
+			testSandbox.InjectFaults(FailEvery(1, errors.New("Ope")))
+			fn3Stub := testSandbox.Function(&fn3, nil)
+
+			n, err := fn1("", 0)
+			Expect(n).To(Equal(42))
+			Expect(err).To(MatchError("Ope"))
+			Expect(fn3(42)).To(MatchError("Ope"))
+			Expect(fn2("")).To(Equal(42))
+			Expect(fn2Stub.faults).To(BeNil())
+			Expect(fn3Stub.faults).ToNot(BeNil())
+		})
+
+		It("derives a separate stream of faults for each stub from the seed", func() {
+			fn1Stub := testSandbox.Function(&fn1, 42, nil)
+
+			testSandbox.InjectFaults(FailWith(errors.New("Ope"), 0.5, 0))
+			fn3Stub := testSandbox.Function(&fn3, nil)
+
+			Expect(fn1Stub.faults.seed).ToNot(BeZero())
+			Expect(fn3Stub.faults.seed).To(Equal(fn1Stub.faults.seed))
+			Expect(fn1Stub.faults.policy.stream).To(Equal(int64(0)))
+			Expect(fn3Stub.faults.policy.stream).To(Equal(int64(1)))
+		})
+
+		It("does not reuse the streams of stubs created before the sandbox was restored", func() {
+			_ = testSandbox.Function(&fn1, 42, nil)
+			testSandbox.Restore()
+
+			testSandbox.InjectFaults(FailWith(errors.New("Ope"), 0.5, 0))
+			fn3Stub := testSandbox.Function(&fn3, nil)
+
+			Expect(fn3Stub.faults.policy.stream).To(Equal(int64(1)))
+		})
+	})
+
 	Describe("Restore", func() {
 		BeforeEach(func() {
 			_ = testSandbox.Function(&fn1, 42, nil)
@@ -174,5 +218,16 @@ var _ = Describe("sandbox", func() {
 
 			Expect(testSandbox.stubs).To(HaveLen(0))
 		})
+
+		It("removes the fault policy", func() {
+			testSandbox.InjectFaults(FailEvery(1, errors.New("Ope")))
+
+			testSandbox.Restore()
+			fn3Stub := testSandbox.Function(&fn3, nil)
+			defer testSandbox.Restore()
+
+			Expect(testSandbox.faults).To(BeNil())
+			Expect(fn3Stub.faults).To(BeNil())
+		})
 	})
 })
@@ -0,0 +1,103 @@
+package mocka
+
+// ExhaustionPolicy describes what a Sequence returns once all of its
+// return values have been used
+type ExhaustionPolicy int
+
+const (
+	// UseDefault falls back to the default return values once the sequence is exhausted
+	UseDefault ExhaustionPolicy = iota
+	// RepeatLast repeats the last return values once the sequence is exhausted
+	RepeatLast
+	// Cycle starts over from the first return values once the sequence is exhausted
+	Cycle
+	// FailTest reports a test failure once the sequence is exhausted
+	FailTest
+)
+
+// Sequence describes a set of return values that are returned in order
+type Sequence struct {
+	stub    *Stub
+	values  [][]interface{}
+	onCalls []*OnCall
+	policy  ExhaustionPolicy
+}
+
+// newSequence validates the return values and assigns them to the OnCalls
+// for the matching call indexes
+func newSequence(stub *Stub, onCalls *[]*OnCall, returnValues [][]interface{}) *Sequence {
+	if len(returnValues) == 0 {
+		stub.testReporter.Errorf("mocka: expected at least one set of return values for ReturnsInOrder")
+		return &Sequence{stub: stub}
+	}
+
+	for _, values := range returnValues {
+		if !validateOutParameters(stub.toType(), values) {
+			reportInvalidOutParameters(stub.testReporter, stub.toType(), values)
+			return &Sequence{stub: stub}
+		}
+	}
+
+	sequence := &Sequence{stub: stub, values: returnValues, onCalls: make([]*OnCall, len(returnValues)), policy: UseDefault}
+	for i, values := range returnValues {
+		o := getOrAddOnCall(stub, onCalls, i, i)
+		o.out = values
+		o.sequence = sequence
+		sequence.onCalls[i] = o
+	}
+
+	return sequence
+}
+
+// release removes the return values the sequence assigned to its OnCalls,
+// unless they have been replaced since
+func (s *Sequence) release() {
+	if s == nil {
+		return
+	}
+
+	for _, o := range s.onCalls {
+		if o.sequence == s {
+			o.out = nil
+			o.sequence = nil
+		}
+	}
+}
+
+// WhenExhausted sets the policy used once all the return values
+// in the sequence have been returned
+func (s *Sequence) WhenExhausted(policy ExhaustionPolicy) *Sequence {
+	s.stub.lock.Lock()
+	defer s.stub.lock.Unlock()
+
+	s.policy = policy
+	return s
+}
+
+// exhausted returns the return values for a call index after the sequence
+// has been exhausted; the boolean is false if the default should be used
+func (s *Sequence) exhausted(callIndex int) ([]interface{}, bool) {
+	if s == nil || len(s.values) == 0 || callIndex < len(s.values) {
+		return nil, false
+	}
+
+	switch s.policy {
+	case RepeatLast:
+		return s.values[len(s.values)-1], true
+	case Cycle:
+		return s.values[callIndex%len(s.values)], true
+	default:
+		return nil, false
+	}
+}
+
+// fails returns true if the call index is past the end of the sequence
+// and the policy is FailTest
+func (s *Sequence) fails(callIndex int) bool {
+	return s != nil && len(s.values) > 0 && callIndex >= len(s.values) && s.policy == FailTest
+}
+
+// reportFailure reports that the sequence was exhausted by the call index
+func (s *Sequence) reportFailure(callIndex int) {
+	s.stub.testReporter.Errorf("mocka: expected at most %v calls for ReturnsInOrder, but the function was called %v times", len(s.values), callIndex+1)
+}
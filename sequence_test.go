@@ -0,0 +1,164 @@
+package mocka
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sequence", func() {
+	var (
+		fn               func(string, int) (int, error)
+		stub             *Stub
+		failTestReporter *mockTestReporter
+	)
+
+	BeforeEach(func() {
+		fn = func(str string, num int) (int, error) {
+			return len(str) + num, nil
+		}
+		failTestReporter = &mockTestReporter{}
+		stub = &Stub{
+			testReporter:  failTestReporter,
+			originalFunc:  nil,
+			functionPtr:   &fn,
+			outParameters: []interface{}{42, nil},
+			execFunc:      func([]interface{}) {},
+		}
+	})
+
+	Describe("newSequence", func() {
+		It("reports an error if no return values are provided", func() {
+			sequence := newSequence(stub, &stub.onCalls, nil)
+
+			Expect(sequence.values).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected at least one set of return values for ReturnsInOrder",
+			}))
+		})
+
+		It("overrides the return values of existing onCalls", func() {
+			existing := &OnCall{stub: stub, index: 1, out: []interface{}{7, nil}}
+			stub.onCalls = []*OnCall{existing}
+
+			_ = newSequence(stub, &stub.onCalls, [][]interface{}{{1, nil}, {2, nil}})
+
+			Expect(stub.onCalls).To(HaveLen(2))
+			Expect(existing.out).To(Equal([]interface{}{2, nil}))
+		})
+	})
+
+	Describe("release", func() {
+		It("removes the return values the sequence assigned to its onCalls", func() {
+			sequence := newSequence(stub, &stub.onCalls, [][]interface{}{{1, nil}, {2, nil}})
+
+			sequence.release()
+
+			Expect(stub.onCalls).To(HaveLen(2))
+			Expect(stub.onCalls[0].out).To(BeNil())
+			Expect(stub.onCalls[1].out).To(BeNil())
+		})
+
+		It("keeps the return values that have been replaced since", func() {
+			sequence := newSequence(stub, &stub.onCalls, [][]interface{}{{1, nil}, {2, nil}})
+			stub.onCalls[1].Return(7, nil)
+
+			sequence.release()
+
+			Expect(stub.onCalls[0].out).To(BeNil())
+			Expect(stub.onCalls[1].out).To(Equal([]interface{}{7, nil}))
+		})
+
+		It("keeps the return values replaced with the same values", func() {
+			values := []interface{}{2, nil}
+			sequence := newSequence(stub, &stub.onCalls, [][]interface{}{{1, nil}, values})
+			stub.onCalls[1].Return(values...)
+
+			sequence.release()
+
+			Expect(stub.onCalls[1].out).To(Equal([]interface{}{2, nil}))
+		})
+
+		It("keeps the return values assigned by a newer sequence", func() {
+			var noOut func()
+			stub.functionPtr = &noOut
+			sequence := newSequence(stub, &stub.onCalls, [][]interface{}{{}, {}})
+			newer := newSequence(stub, &stub.onCalls, [][]interface{}{{}})
+
+			sequence.release()
+
+			Expect(stub.onCalls[0].out).To(Equal([]interface{}{}))
+			Expect(stub.onCalls[0].sequence).To(BeIdenticalTo(newer))
+			Expect(stub.onCalls[1].out).To(BeNil())
+		})
+
+		It("does nothing for a nil sequence", func() {
+			var sequence *Sequence
+
+			Expect(sequence.release).ToNot(Panic())
+		})
+	})
+
+	Describe("WhenExhausted", func() {
+		It("assigns the exhaustion policy", func() {
+			sequence := &Sequence{stub: stub}
+
+			result := sequence.WhenExhausted(Cycle)
+
+			Expect(result).To(BeIdenticalTo(sequence))
+			Expect(sequence.policy).To(Equal(Cycle))
+		})
+	})
+
+	DescribeTable("exhausted",
+		func(policy ExhaustionPolicy, callIndex int, expected []interface{}, expectedOk bool) {
+			sequence := &Sequence{stub: stub, values: [][]interface{}{{1, nil}, {2, nil}, {3, nil}}, policy: policy}
+
+			out, ok := sequence.exhausted(callIndex)
+
+			Expect(ok).To(Equal(expectedOk))
+			Expect(out).To(Equal(expected))
+		},
+		Entry("uses the onCalls before the sequence is exhausted", RepeatLast, 2, nil, false),
+		Entry("uses the default with UseDefault", UseDefault, 3, nil, false),
+		Entry("repeats the last values with RepeatLast", RepeatLast, 7, []interface{}{3, nil}, true),
+		Entry("cycles the values with Cycle", Cycle, 4, []interface{}{2, nil}, true),
+		Entry("uses the default with FailTest", FailTest, 3, nil, false),
+	)
+
+	DescribeTable("fails",
+		func(policy ExhaustionPolicy, callIndex int, expected bool) {
+			sequence := &Sequence{stub: stub, values: [][]interface{}{{1, nil}}, policy: policy}
+
+			Expect(sequence.fails(callIndex)).To(Equal(expected))
+			Expect(failTestReporter.messages).To(BeEmpty())
+		},
+		Entry("before the sequence is exhausted", FailTest, 0, false),
+		Entry("once the sequence is exhausted with FailTest", FailTest, 1, true),
+		Entry("once the sequence is exhausted with another policy", UseDefault, 1, false),
+	)
+
+	It("does not fail when the sequence is nil", func() {
+		var sequence *Sequence
+
+		Expect(sequence.fails(5)).To(BeFalse())
+	})
+
+	It("reports an error when the sequence is exhausted with FailTest", func() {
+		sequence := &Sequence{stub: stub, values: [][]interface{}{{1, nil}}, policy: FailTest}
+
+		sequence.reportFailure(1)
+
+		Expect(failTestReporter.messages).To(Equal([]string{
+			"mocka: expected at most 1 calls for ReturnsInOrder, but the function was called 2 times",
+		}))
+	})
+
+	It("returns false when the sequence is nil", func() {
+		var sequence *Sequence
+
+		_, ok := sequence.exhausted(5)
+
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -3,6 +3,7 @@ package mocka
 import (
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/Bayer-Group/mocka/v2/match"
 )
@@ -21,6 +22,12 @@ type Stub struct {
 	calls         []Call
 	customArgs    []*CustomArguments
 	onCalls       []*OnCall
+	sequence      *Sequence
+	wait          waiter
+	gate          *Gate
+	faults        *faultInjector
+	fuzz          *fuzzDecoder
+	restored      chan struct{}
 	execFunc      func([]interface{})
 }
 
@@ -78,14 +85,51 @@ func (stub *Stub) toType() reflect.Type {
 // implementation defines the function that replaces the original
 // function's functionality
 func (stub *Stub) implementation(arguments []reflect.Value) []reflect.Value {
+	call := stub.recordCall(arguments)
+
+	if call.gate != nil {
+		call.gate.park()
+	}
+
+	if call.wait != nil {
+		if err := call.wait(call.arguments, stub.restoredChannel()); err != nil {
+			stub.replaceErrorOutParameter(call.out, call.index, err)
+		}
+	}
+
+	return call.out
+}
+
+// pendingCall describes a call that has been recorded, but has yet to return
+type pendingCall struct {
+	index     int
+	arguments []interface{}
+	out       []reflect.Value
+	gate      *Gate
+	wait      waiter
+}
+
+// recordCall resolves the out parameters for the provided arguments and records
+// the call. Anything that could block the call is returned to be run once the
+// stub is unlocked.
+func (stub *Stub) recordCall(arguments []reflect.Value) pendingCall {
 	stub.lock.Lock()
 	defer stub.lock.Unlock()
 
 	functionType := stub.toType()
 	argumentsAsInterfaces := mapToInterfaces(arguments)
-	outParameters, maybeCustomArguments := stub.getReturnValues(argumentsAsInterfaces, functionType)
+	rules := stub.evaluateRules(argumentsAsInterfaces)
+	outParameters, maybeCustomArguments, resolution := stub.getReturnValues(rules, functionType)
+	wait := stub.getWaiter(maybeCustomArguments)
 	outParametersAsValues := mapToReflectValue(outParameters)
 
+	if stub.faults != nil {
+		if err := stub.faults.fault(len(stub.calls)); err != nil {
+			outParametersAsValues[errorOutIndex(functionType)] = reflect.ValueOf(err)
+			resolution.Source = FaultReturn
+		}
+	}
+
 	outParametersAsInterfaces := make([]interface{}, len(outParametersAsValues))
 	for index, value := range outParametersAsValues {
 		outParamType := functionType.Out(index)
@@ -105,45 +149,140 @@ func (stub *Stub) implementation(arguments []reflect.Value) []reflect.Value {
 
 	stub.execFunc(argumentsAsInterfaces)
 
-	stub.calls = append(stub.calls, Call{args: argumentsAsInterfaces, out: outParametersAsInterfaces})
+	call := Call{args: argumentsAsInterfaces, out: outParametersAsInterfaces, resolution: resolution, rules: rules}
+	if stub.fuzz != nil {
+		call.fuzzInput = stub.fuzz.last
+	}
+
+	stub.calls = append(stub.calls, call)
 
 	if maybeCustomArguments != nil {
 		maybeCustomArguments.callCount++
 	}
 
-	return outParametersAsValues
+	return pendingCall{
+		index:     len(stub.calls) - 1,
+		arguments: argumentsAsInterfaces,
+		out:       outParametersAsValues,
+		gate:      stub.gate,
+		wait:      wait,
+	}
 }
 
-// getReturnValues returns the correct out parameters based on the
-// arguments passed into the function.
+// replaceErrorOutParameter replaces the trailing error out parameter of a call
+// with the provided error, if the function returns an error. Only
+// BlockUntilContextDone returns an error, so the call is recorded as returning
+// the error of a done context.
+func (stub *Stub) replaceErrorOutParameter(outParametersAsValues []reflect.Value, callIndex int, err error) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	index := errorOutIndex(stub.toType())
+	if index == -1 {
+		return
+	}
+
+	errValue := reflect.New(errorType).Elem()
+	errValue.Set(reflect.ValueOf(err))
+	outParametersAsValues[index] = errValue
+
+	if callIndex < len(stub.calls) {
+		stub.calls[callIndex].out[index] = err
+		stub.calls[callIndex].resolution.Source = ContextDoneReturn
+	}
+}
+
+// getWaiter returns the most specific waiter for the current call; otherwise nil
 //
-// This function also takes into account the current call index of function.
-func (stub *Stub) getReturnValues(arguments []interface{}, functionType reflect.Type) ([]interface{}, *CustomArguments) {
-	out := stub.outParameters
+// This function should be called before the call is recorded.
+func (stub *Stub) getWaiter(maybeCustomArgs *CustomArguments) waiter {
+	if maybeCustomArgs != nil {
+		if o := findOnCallWaiter(maybeCustomArgs.onCalls, maybeCustomArgs.callCount); o != nil {
+			return o.wait
+		}
 
-	for _, o := range stub.onCalls {
-		if o.index == len(stub.calls) && o.out != nil {
-			out = o.out
-			break
+		if maybeCustomArgs.wait != nil {
+			return maybeCustomArgs.wait
 		}
 	}
 
-	maybeCustomArgs := getHighestPriority(getPossible(stub.customArgs, arguments), functionType.NumIn())
-	if maybeCustomArgs == nil {
-		return out, nil
+	if o := findOnCallWaiter(stub.onCalls, len(stub.calls)); o != nil {
+		return o.wait
 	}
 
-	if maybeCustomArgs.out != nil {
-		out = maybeCustomArgs.out
+	return stub.wait
+}
+
+// restoredChannel returns the channel that is closed when the stub is restored
+func (stub *Stub) restoredChannel() <-chan struct{} {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if stub.restored == nil {
+		stub.restored = make(chan struct{})
+	}
+
+	return stub.restored
+}
+
+// getReturnValues returns the correct out parameters based on how the
+// arguments passed into the function compare against the WithArgs rules.
+//
+// This function also takes into account the current call index of function.
+func (stub *Stub) getReturnValues(rules []ruleOutcome, functionType reflect.Type) ([]interface{}, *CustomArguments, Resolution) {
+	resolution := Resolution{Source: DefaultReturn, Rule: -1}
+
+	out := stub.outParameters
+	if stub.fuzz != nil {
+		out = stub.fuzz.next(functionType)
+		resolution.Source = FuzzReturn
+	}
+
+	sequenceFails := false
+	if o := findOnCall(stub.onCalls, len(stub.calls)); o != nil {
+		out = o.out
+		resolution.Source = OnCallReturn
+	} else if sequenceOut, ok := stub.sequence.exhausted(len(stub.calls)); ok {
+		out = sequenceOut
+		resolution.Source = SequenceReturn
+	} else {
+		sequenceFails = stub.sequence.fails(len(stub.calls))
+	}
+
+	possible := getPossible(rules)
+	resolution.MatchedRules = stub.matchedRules(possible)
+
+	maybeCustomArgs := getHighestPriority(possible, functionType.NumIn())
+	for _, rule := range rules {
+		rule.customArgs.settleCaptures(rule.customArgs == maybeCustomArgs)
 	}
 
-	for _, o := range maybeCustomArgs.onCalls {
-		if o.index == maybeCustomArgs.callCount && o.out != nil {
-			return o.out, maybeCustomArgs
+	if maybeCustomArgs != nil {
+		resolution.Rule = stub.ruleIndex(maybeCustomArgs)
+
+		if maybeCustomArgs.out != nil {
+			out = maybeCustomArgs.out
+			resolution.Source = WithArgsReturn
+		}
+
+		if o := findOnCall(maybeCustomArgs.onCalls, maybeCustomArgs.callCount); o != nil {
+			out = o.out
+			resolution.Source = WithArgsOnCallReturn
+		} else if sequenceOut, ok := maybeCustomArgs.sequence.exhausted(maybeCustomArgs.callCount); ok {
+			out = sequenceOut
+			resolution.Source = WithArgsSequenceReturn
+		} else if maybeCustomArgs.sequence.fails(maybeCustomArgs.callCount) {
+			maybeCustomArgs.sequence.reportFailure(maybeCustomArgs.callCount)
 		}
 	}
 
-	return out, maybeCustomArgs
+	// The sequence of the stub only fails the test if it would have provided
+	// the return values, rather than a WithArgs rule
+	if sequenceFails && !resolution.Source.fromWithArgs() {
+		stub.sequence.reportFailure(len(stub.calls))
+	}
+
+	return out, maybeCustomArgs, resolution
 }
 
 // getHighestPriority returns the highest priority custom arguments if found;
@@ -182,12 +321,42 @@ func getHighestPriority(customArgs []*CustomArguments, numArgs int) *CustomArgum
 	return customArgs[0]
 }
 
-// getPossible returns the possible custom arguments
-// that match the provided arguments
-func getPossible(customArgs []*CustomArguments, arguments []interface{}) (possible []*CustomArguments) {
-	for _, ca := range customArgs {
-		if ca != nil && ca.isMatch(arguments) {
-			possible = append(possible, ca)
+// ruleOutcome describes whether the arguments of a call matched a WithArgs rule.
+// failed is the index of the first argument that did not match, or -1 if every
+// argument matched, and reason is why it did not match if its matcher panicked
+// or is missing.
+type ruleOutcome struct {
+	customArgs *CustomArguments
+	failed     int
+	reason     string
+}
+
+// matched returns true if every argument matched the rule
+func (r ruleOutcome) matched() bool {
+	return r.failed < 0
+}
+
+// evaluateRules compares the arguments against each WithArgs rule of the stub
+//
+// This function should be called once per call, since matchers may capture
+// the arguments they match.
+func (stub *Stub) evaluateRules(arguments []interface{}) []ruleOutcome {
+	customArgs := stub.rules()
+	rules := make([]ruleOutcome, len(customArgs))
+	for i, ca := range customArgs {
+		failed, reason := ca.evaluate(arguments)
+		rules[i] = ruleOutcome{customArgs: ca, failed: failed, reason: reason}
+	}
+
+	return rules
+}
+
+// getPossible returns the custom arguments of the rules
+// that match the arguments
+func getPossible(rules []ruleOutcome) (possible []*CustomArguments) {
+	for _, rule := range rules {
+		if rule.matched() {
+			possible = append(possible, rule.customArgs)
 		}
 	}
 	return
@@ -207,6 +376,23 @@ func (stub *Stub) Return(returnValues ...interface{}) {
 	stub.outParameters = returnValues
 }
 
+// ReturnsInOrder sets the return values for each call in the order they are
+// provided. Once every set of return values has been used the stub will fall
+// back to the default return values, unless a different ExhaustionPolicy
+// is set with WhenExhausted.
+func (stub *Stub) ReturnsInOrder(returnValues ...[]interface{}) *Sequence {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	sequence := newSequence(stub, &stub.onCalls, returnValues)
+	if sequence.values != nil {
+		stub.sequence.release()
+		stub.sequence = sequence
+	}
+
+	return sequence
+}
+
 // WithArgs returns a StubWithArgs that can change the out parameters
 // returned based on the arguments provided to this function
 func (stub *Stub) WithArgs(arguments ...interface{}) *CustomArguments {
@@ -324,15 +510,31 @@ func (stub *Stub) OnCall(index int) *OnCall {
 	stub.lock.Lock()
 	defer stub.lock.Unlock()
 
-	for _, o := range stub.onCalls {
-		if o.index == index {
-			return o
-		}
+	return getOrAddOnCall(stub, &stub.onCalls, index, index)
+}
+
+// OnCalls returns an interface that allows for changing the
+// return values for a range of call indexes. Both the from
+// and to call indexes are inclusive.
+func (stub *Stub) OnCalls(from int, to int) *OnCall {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if to < from {
+		stub.testReporter.Errorf("mocka: expected the last call index (%v) to be greater than or equal to the first call index (%v)", to, from)
+		return &OnCall{index: from, stub: stub}
 	}
 
-	o := &OnCall{index: index, stub: stub}
-	stub.onCalls = append(stub.onCalls, o)
-	return o
+	return getOrAddOnCall(stub, &stub.onCalls, from, to)
+}
+
+// OnCallsFrom returns an interface that allows for changing the
+// return values for every call from the provided call index onwards.
+func (stub *Stub) OnCallsFrom(index int) *OnCall {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	return getOrAddOnCall(stub, &stub.onCalls, index, lastCallIndex)
 }
 
 // OnFirstCall returns an interface that allows for changing the
@@ -363,6 +565,83 @@ func (stub *Stub) Restore() {
 	functionValue := reflect.ValueOf(stub.functionPtr).Elem()
 
 	functionValue.Set(valueOforiginalFunc)
+
+	// release any calls that are still blocked by the stub
+	if stub.restored == nil {
+		stub.restored = make(chan struct{})
+	}
+
+	select {
+	case <-stub.restored:
+	default:
+		close(stub.restored)
+	}
+
+	if stub.gate != nil {
+		stub.gate.ReleaseAll()
+	}
+}
+
+// Delay makes the stub sleep for the provided duration before returning
+func (stub *Stub) Delay(d time.Duration) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.wait = delay(d)
+}
+
+// BlockUntil makes the stub block until the provided channel is closed
+// or receives a value before returning
+func (stub *Stub) BlockUntil(ch <-chan struct{}) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	stub.wait = blockUntil(ch)
+}
+
+// BlockUntilContextDone makes the stub block until the first context.Context
+// argument is done. The context's error will be returned as the error out
+// parameter, if the function returns an error.
+func (stub *Stub) BlockUntilContextDone() {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if !hasContextArgument(stub.toType()) {
+		reportMissingContextArgument(stub.testReporter, stub.toType())
+		return
+	}
+
+	stub.wait = blockUntilContextDone()
+}
+
+// Gate returns a controller that parks every call to the stub until
+// the call is released by the controller.
+func (stub *Stub) Gate() *Gate {
+	restored := stub.restoredChannel()
+
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if stub.gate == nil {
+		stub.gate = newGate(restored)
+	}
+
+	return stub.gate
+}
+
+// InjectFaults injects the error of the fault policy into the trailing error
+// out parameter, when the policy decides a call should fail. All other out
+// parameters are left as they were configured.
+func (stub *Stub) InjectFaults(policy FaultPolicy) {
+	stub.lock.Lock()
+	defer stub.lock.Unlock()
+
+	if !policy.validate(stub.testReporter, stub.toType()) {
+		return
+	}
+
+	stub.faults = newFaultInjector(policy)
+	reportSeedOnFailure(stub.testReporter, stub.toType(), stub.faults)
 }
 
 // ExecOnCall assigns a function to be called when the stub
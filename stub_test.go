@@ -1,8 +1,10 @@
 package mocka
 
 import (
+	"context"
 	"errors"
 	"reflect"
+	"time"
 
 	"github.com/Bayer-Group/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
@@ -139,7 +141,7 @@ var _ = Describe("stub", func() {
 		It("returns the Stub.OutParameters if no customArgs or onCalls exist", func() {
 			args := []interface{}{"Hello", 42}
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
@@ -149,7 +151,7 @@ var _ = Describe("stub", func() {
 			args := []interface{}{"Hello", 42}
 			stub.customArgs = append(stub.customArgs, nil, nil, nil)
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
@@ -170,7 +172,7 @@ var _ = Describe("stub", func() {
 					out:         []interface{}{98, nil},
 				})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{42, nil}))
 			Expect(maybeCustomArguments).To(BeNil())
@@ -198,7 +200,7 @@ var _ = Describe("stub", func() {
 				expected,
 			)
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{22, errors.New("I am an error")}))
 			Expect(maybeCustomArguments).To(Equal(expected))
@@ -229,7 +231,7 @@ var _ = Describe("stub", func() {
 				out:   []interface{}{22, errors.New("I am the first error")},
 			})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{22, errors.New("I am the first error")}))
 			Expect(maybeCustomArguments).To(BeNil())
@@ -258,7 +260,7 @@ var _ = Describe("stub", func() {
 				out:   []interface{}{22, errors.New("I am the first error")},
 			})
 
-			result, maybeCustomArguments := stub.getReturnValues(args, reflect.TypeOf(fn))
+			result, maybeCustomArguments, _ := stub.getReturnValues(stub.evaluateRules(args), reflect.TypeOf(fn))
 
 			Expect(result).To(Equal([]interface{}{23, errors.New("I am the third not an apple")}))
 			Expect(maybeCustomArguments).To(Equal(expected))
@@ -436,6 +438,54 @@ var _ = Describe("stub", func() {
 			Expect(withArgs.argMatchers).To(Equal([]match.SupportedKindsMatcher{match.Exactly("apple"), match.Exactly(0)}))
 			Expect(withArgs.out).To(BeNil())
 		})
+
+		It("creates new custom arguments for different capture matchers with equal destinations", func() {
+			var first, second string
+
+			firstArgs := stub.WithArgs(match.Capture(&first), 1)
+			secondArgs := stub.WithArgs(match.Capture(&second), 1)
+
+			Expect(secondArgs).ToNot(BeIdenticalTo(firstArgs))
+			Expect(stub.customArgs).To(HaveLen(2))
+		})
+	})
+
+	Describe("Same", func() {
+		It("creates separate custom arguments for different pointers to equal values", func() {
+			pointerFn := func(*int) int { return 0 }
+			fnStub := newStub(GinkgoT(), &pointerFn, []interface{}{0})
+			defer fnStub.Restore()
+
+			first, second := 1, 1
+			fnStub.WithArgs(match.Same(&first)).Return(1)
+			fnStub.WithArgs(match.Same(&second)).Return(2)
+
+			Expect(fnStub.customArgs).To(HaveLen(2))
+			Expect(pointerFn(&first)).To(Equal(1))
+			Expect(pointerFn(&second)).To(Equal(2))
+		})
+	})
+
+	Describe("captures", func() {
+		It("captures the values only for the custom arguments used by the call", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			var captured, other string
+			var all []int
+			fnStub.WithArgs(match.Capture(&captured), 1).Return(1, nil)
+			fnStub.WithArgs(match.Capture(&other), 2).Return(2, nil)
+			fnStub.WithArgs("priority", match.CaptureAll(&all)).Return(3, nil)
+
+			_, _ = fn("first", 1)
+			_, _ = fn("second", 3)
+			_, _ = fn("priority", 1)
+			_, _ = fn("third", 2)
+
+			Expect(captured).To(Equal("first"))
+			Expect(other).To(Equal("third"))
+			Expect(all).To(Equal([]int{1}))
+		})
 	})
 
 	Describe("CallCount", func() {
@@ -760,6 +810,172 @@ var _ = Describe("stub", func() {
 		})
 	})
 
+	Describe("OnCalls", func() {
+		It("returns a pointer to an onCall struct covering the range", func() {
+			result := stub.OnCalls(2, 4)
+
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 2, lastIndex: 4}))
+			Expect(stub.onCalls).To(HaveLen(1))
+		})
+
+		It("returns an existing onCall object if one exists for that range", func() {
+			first := stub.OnCalls(2, 4)
+			second := stub.OnCalls(2, 4)
+
+			Expect(second).To(BeIdenticalTo(first))
+			Expect(stub.onCalls).To(HaveLen(1))
+		})
+
+		It("returns the onCall for a single index when from and to are the same", func() {
+			result := stub.OnCalls(3, 3)
+
+			Expect(result).To(BeIdenticalTo(stub.OnCall(3)))
+		})
+
+		It("reports an error when to is lower than from", func() {
+			stub.testReporter = failTestReporter
+
+			_ = stub.OnCalls(4, 2)
+
+			Expect(stub.onCalls).To(BeEmpty())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the last call index (2) to be greater than or equal to the first call index (4)",
+			}))
+		})
+
+		It("returns the values for each call in the range", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.OnCalls(1, 2).Return(7, nil)
+			fnStub.OnCall(2).Return(8, nil)
+
+			results := make([]int, 4)
+			for i := range results {
+				results[i], _ = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]int{42, 7, 8, 42}))
+		})
+	})
+
+	Describe("OnCallsFrom", func() {
+		It("returns a pointer to an onCall struct covering every call after the index", func() {
+			result := stub.OnCallsFrom(3)
+
+			Expect(*result).To(Equal(OnCall{stub: stub, index: 3, lastIndex: lastCallIndex}))
+		})
+
+		It("returns the values for every call from the index onwards", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.OnCallsFrom(2).Return(0, errors.New("Ope"))
+
+			results := make([]error, 5)
+			for i := range results {
+				_, results[i] = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]error{nil, nil, errors.New("Ope"), errors.New("Ope"), errors.New("Ope")}))
+		})
+	})
+
+	Describe("ReturnsInOrder", func() {
+		It("assigns the return values to the onCalls for each index", func() {
+			sequence := stub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil})
+
+			Expect(stub.sequence).To(BeIdenticalTo(sequence))
+			Expect(stub.onCalls).To(Equal([]*OnCall{
+				{stub: stub, index: 0, out: []interface{}{1, nil}, sequence: sequence},
+				{stub: stub, index: 1, out: []interface{}{2, nil}, sequence: sequence},
+			}))
+		})
+
+		It("reports an error if any of the return values are invalid", func() {
+			stub.testReporter = failTestReporter
+
+			_ = stub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{"2", nil})
+
+			Expect(stub.sequence).To(BeNil())
+			Expect(stub.onCalls).To(BeEmpty())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected return values of type (int, error), but received (string, <nil>)",
+			}))
+		})
+
+		It("returns the values in order and then the default", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, errors.New("Ope")})
+			defer fnStub.Restore()
+
+			fnStub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}, []interface{}{3, nil})
+
+			results := make([]int, 5)
+			for i := range results {
+				results[i], _ = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]int{1, 2, 3, 0, 0}))
+		})
+
+		It("cycles through the values when the policy is Cycle", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}).WhenExhausted(Cycle)
+
+			results := make([]int, 5)
+			for i := range results {
+				results[i], _ = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]int{1, 2, 1, 2, 1}))
+		})
+
+		It("replaces the values of a previous sequence", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.ReturnsInOrder([]interface{}{1, nil}, []interface{}{2, nil}, []interface{}{3, nil})
+			fnStub.ReturnsInOrder([]interface{}{9, nil}).WhenExhausted(RepeatLast)
+
+			results := make([]int, 3)
+			for i := range results {
+				results[i], _ = fn("", 0)
+			}
+
+			Expect(results).To(Equal([]int{9, 9, 9}))
+		})
+
+		It("reports an error once the values are exhausted with FailTest", func() {
+			fnStub := newStub(failTestReporter, &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.ReturnsInOrder([]interface{}{1, nil}).WhenExhausted(FailTest)
+
+			_, _ = fn("", 0)
+			_, _ = fn("", 0)
+
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected at most 1 calls for ReturnsInOrder, but the function was called 2 times",
+			}))
+		})
+
+		It("does not report an error for calls that use the return values of a WithArgs rule", func() {
+			fnStub := newStub(failTestReporter, &fn, []interface{}{0, nil})
+			defer fnStub.Restore()
+
+			fnStub.ReturnsInOrder([]interface{}{1, nil}).WhenExhausted(FailTest)
+			fnStub.WithArgs("custom", 0).Return(2, nil)
+
+			first, _ := fn("", 0)
+			second, _ := fn("custom", 0)
+
+			Expect(failTestReporter.messages).To(BeEmpty())
+			Expect([]int{first, second}).To(Equal([]int{1, 2}))
+		})
+	})
+
 	Describe("OnFirstCall", func() {
 		It("creates a new onCall with a 0 index", func() {
 			stub.onCalls = []*OnCall{
@@ -866,6 +1082,191 @@ var _ = Describe("stub", func() {
 		})
 	})
 
+	Describe("Delay", func() {
+		It("sleeps before returning", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.Delay(20 * time.Millisecond)
+
+			start := time.Now()
+			_, _ = fn("", 0)
+
+			Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+		})
+	})
+
+	Describe("BlockUntil", func() {
+		It("blocks until the channel is closed without holding the stub lock", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			ch := make(chan struct{})
+			done := make(chan struct{})
+			fnStub.BlockUntil(ch)
+
+			go func() {
+				defer close(done)
+				_, _ = fn("", 0)
+			}()
+
+			Eventually(fnStub.CallCount).Should(Equal(1))
+			Consistently(done, 20*time.Millisecond).ShouldNot(BeClosed())
+			close(ch)
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("releases blocked calls when the stub is restored", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			done := make(chan struct{})
+			fnStub.BlockUntil(make(chan struct{}))
+
+			go func() {
+				defer close(done)
+				_, _ = fn("", 0)
+			}()
+
+			Eventually(fnStub.CallCount).Should(Equal(1))
+			fnStub.Restore()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	Describe("BlockUntilContextDone", func() {
+		var ctxFn func(context.Context, string) (int, error)
+
+		BeforeEach(func() {
+			ctxFn = func(context.Context, string) (int, error) {
+				return 0, nil
+			}
+		})
+
+		It("reports an error if the function does not have a context argument", func() {
+			stub.testReporter = failTestReporter
+
+			stub.BlockUntilContextDone()
+
+			Expect(stub.wait).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected a function with a context.Context argument for BlockUntilContextDone, but received func(string, int) (int, error) {}",
+			}))
+		})
+
+		It("returns the context error as the error out parameter", func() {
+			fnStub := newStub(GinkgoT(), &ctxFn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.BlockUntilContextDone()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			n, err := ctxFn(ctx, "")
+
+			Expect(n).To(Equal(42))
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(fnStub.GetFirstCall().ReturnValues()).To(Equal([]interface{}{42, context.DeadlineExceeded}))
+		})
+	})
+
+	Describe("getWaiter", func() {
+		var stubWaiter, onCallWaiter, caWaiter, caOnCallWaiter waiter
+
+		namedWaiter := func(name string) waiter {
+			return func([]interface{}, <-chan struct{}) error {
+				return errors.New(name)
+			}
+		}
+
+		BeforeEach(func() {
+			stubWaiter = namedWaiter("stub")
+			onCallWaiter = namedWaiter("onCall")
+			caWaiter = namedWaiter("customArguments")
+			caOnCallWaiter = namedWaiter("customArguments onCall")
+		})
+
+		It("returns nil when no waiter is set", func() {
+			Expect(stub.getWaiter(nil)).To(BeNil())
+		})
+
+		It("returns the stub waiter when nothing else is set", func() {
+			stub.wait = stubWaiter
+
+			Expect(stub.getWaiter(nil)(nil, nil)).To(MatchError("stub"))
+		})
+
+		It("returns the most specific waiter", func() {
+			stub.wait = stubWaiter
+			stub.onCalls = []*OnCall{{stub: stub, index: 0, wait: onCallWaiter}}
+			ca := &CustomArguments{
+				stub:    stub,
+				wait:    caWaiter,
+				onCalls: []*OnCall{{stub: stub, index: 1, wait: caOnCallWaiter}},
+			}
+
+			Expect(stub.getWaiter(nil)(nil, nil)).To(MatchError("onCall"))
+			Expect(stub.getWaiter(ca)(nil, nil)).To(MatchError("customArguments"))
+
+			ca.callCount = 1
+
+			Expect(stub.getWaiter(ca)(nil, nil)).To(MatchError("customArguments onCall"))
+		})
+	})
+
+	Describe("InjectFaults", func() {
+		It("reports an error if the function does not return an error", func() {
+			fn := func() int { return 0 }
+			stub.functionPtr = &fn
+			stub.testReporter = failTestReporter
+
+			stub.InjectFaults(FailEvery(2, errors.New("Ope")))
+
+			Expect(stub.faults).To(BeNil())
+			Expect(failTestReporter.messages).To(Equal([]string{
+				"mocka: expected the last return value to be an error to inject faults, but received func() (int) {}",
+			}))
+		})
+
+		It("only replaces the error out parameter", func() {
+			fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+			defer fnStub.Restore()
+
+			fnStub.InjectFaults(FailEvery(2, errors.New("Ope")))
+
+			results := make([]error, 4)
+			for i := range results {
+				var n int
+				n, results[i] = fn("", 0)
+				Expect(n).To(Equal(42))
+			}
+
+			Expect(results).To(Equal([]error{nil, errors.New("Ope"), nil, errors.New("Ope")}))
+			Expect(fnStub.GetSecondCall().ReturnValues()).To(Equal([]interface{}{42, errors.New("Ope")}))
+		})
+
+		It("injects the same faults for the same seed", func() {
+			collect := func() []error {
+				fnStub := newStub(GinkgoT(), &fn, []interface{}{42, nil})
+				defer fnStub.Restore()
+
+				fnStub.InjectFaults(FailWith(errors.New("Ope"), 0.5, 1234))
+
+				results := make([]error, 20)
+				for i := range results {
+					_, results[i] = fn("", 0)
+				}
+
+				return results
+			}
+
+			first := collect()
+
+			Expect(first).To(ContainElement(BeNil()))
+			Expect(first).To(ContainElement(MatchError("Ope")))
+			Expect(collect()).To(Equal(first))
+		})
+	})
+
 	Describe("ExecOnCall", func() {
 		It("assigns the exec function to the new function provided", func() {
 			called := false
@@ -941,9 +1342,8 @@ var _ = Describe("stub", func() {
 
 	Describe("getPossible", func() {
 		var (
-			customArgs []*CustomArguments
-			matcher1   *CustomArguments
-			matcher2   *CustomArguments
+			matcher1 *CustomArguments
+			matcher2 *CustomArguments
 		)
 
 		BeforeEach(func() {
@@ -957,17 +1357,17 @@ var _ = Describe("stub", func() {
 				argMatchers: []match.SupportedKindsMatcher{match.StringPrefix("custom-"), match.Exactly(0)},
 				out:         nil,
 			}
-			customArgs = []*CustomArguments{matcher1, matcher2}
+			stub.customArgs = []*CustomArguments{matcher1, nil, matcher2}
 		})
 
 		It("returns an empty slice when no matches are found", func() {
-			actual := getPossible(customArgs, []interface{}{"screams", 0})
+			actual := getPossible(stub.evaluateRules([]interface{}{"screams", 0}))
 
 			Expect(actual).To(HaveLen(0))
 		})
 
 		It("returns all possible matches", func() {
-			actual := getPossible(customArgs, []interface{}{"custom-", 0})
+			actual := getPossible(stub.evaluateRules([]interface{}{"custom-", 0}))
 
 			Expect(actual).To(HaveLen(2))
 			Expect(actual).To(ContainElement(matcher1))
@@ -975,7 +1375,7 @@ var _ = Describe("stub", func() {
 		})
 
 		It("returns a single match when only one is possible", func() {
-			actual := getPossible(customArgs, []interface{}{"custom-1", 0})
+			actual := getPossible(stub.evaluateRules([]interface{}{"custom-1", 0}))
 
 			Expect(actual).To(HaveLen(1))
 			Expect(actual).To(ContainElement(matcher2))
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/Bayer-Group/mocka/v2/match"
 )
 
 // mapToInterfaces maps a slice of reflection values to interface values
@@ -112,6 +114,36 @@ func mapToTypeName(interfaces []interface{}) []string {
 	return names
 }
 
+// mapToArgumentName maps a slice of arguments to the descriptions of
+// the matchers and the type names of the other values
+func mapToArgumentName(arguments []interface{}) []string {
+	names := make([]string, len(arguments))
+	for i, value := range arguments {
+		if m, ok := value.(match.SupportedKindsMatcher); ok {
+			names[i] = match.Description(m)
+			continue
+		}
+
+		names[i] = toFriendlyName(value)
+	}
+
+	return names
+}
+
+// argumentType returns the type of the argument at the index and true, using the
+// element type for variadic arguments; otherwise false if there is no such argument
+func argumentType(functionType reflect.Type, argIndex int) (reflect.Type, bool) {
+	if functionType.IsVariadic() && argIndex >= functionType.NumIn()-1 {
+		return functionType.In(functionType.NumIn() - 1).Elem(), true
+	}
+
+	if argIndex < 0 || argIndex >= functionType.NumIn() {
+		return nil, false
+	}
+
+	return functionType.In(argIndex), true
+}
+
 // toFriendlyName returns a type name is a more human readable string
 func toFriendlyName(value interface{}) string {
 	if value == nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/Bayer-Group/mocka/v2/match"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -217,6 +218,39 @@ var _ = Describe("utils", func() {
 		})
 	})
 
+	Describe("mapToArgumentName", func() {
+		It("returns the description of matchers and the type name of other values", func() {
+			input := []interface{}{match.StringPrefix("a"), 10, nil}
+
+			Expect(mapToArgumentName(input)).To(Equal([]string{"string with prefix \"a\"", "int", "<nil>"}))
+		})
+	})
+
+	Describe("argumentType", func() {
+		var fn = func(str string, opts ...int) {}
+		functionType := reflect.TypeOf(fn)
+
+		It("returns the type of the argument", func() {
+			t, ok := argumentType(functionType, 0)
+
+			Expect(ok).To(BeTrue())
+			Expect(t).To(Equal(reflect.TypeOf("")))
+		})
+
+		It("returns the element type of variadic arguments", func() {
+			t, ok := argumentType(functionType, 3)
+
+			Expect(ok).To(BeTrue())
+			Expect(t).To(Equal(reflect.TypeOf(0)))
+		})
+
+		It("returns false when there is no such argument", func() {
+			_, ok := argumentType(reflect.TypeOf(func(string) {}), 1)
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	_readOnlyChan := func() <-chan int {
 		return make(chan int)
 	}